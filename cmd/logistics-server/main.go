@@ -0,0 +1,25 @@
+package main
+
+import (
+	"log"
+
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+)
+
+func main() {
+	cfg := &config.ServerAppConfig{}
+	cfg.LoadFromEnv()
+
+	log.Println("Loaded Configuration from Environment Variables\n", cfg)
+
+	app, cleanup, err := newWire(cfg)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	// start and wait for stop signal
+	if e := app.Run(); e != nil {
+		panic(e)
+	}
+}
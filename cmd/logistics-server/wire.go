@@ -0,0 +1,24 @@
+//go:build wireinject
+// +build wireinject
+
+// The build tag makes sure the stub is not built in the final build.
+
+package main
+
+import (
+	internal "github.com/coopnorge/interview-backend/internal/logistics"
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/server"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/store"
+
+	"github.com/google/wire"
+)
+
+// newWire create new DI
+func newWire(cfg *config.ServerAppConfig) (*internal.ServerInstance, func(), error) {
+	panic(wire.Build(
+		store.ServiceSetForStore,
+		server.ServiceSetForServer,
+		internal.NewServerInstance,
+	))
+}
@@ -0,0 +1,37 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package main
+
+import (
+	"github.com/coopnorge/interview-backend/internal/logistics"
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/server"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/store"
+)
+
+// Injectors from wire.go:
+
+// newWire create new DI
+func newWire(cfg *config.ServerAppConfig) (*internal.ServerInstance, func(), error) {
+	deliveryPathStore, cleanup, err := store.NewDeliveryPathStore(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	movementBroker := server.NewMovementBroker()
+	unitCounter := server.NewUnitCounter()
+	idempotencyCache := server.NewIdempotencyCache()
+	apiLogisticsServer := server.NewLogisticsServer(deliveryPathStore, movementBroker, unitCounter, idempotencyCache)
+	authenticator := server.NewAuthenticator(cfg)
+	serverInstance, err := internal.NewServerInstance(apiLogisticsServer, authenticator, cfg)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return serverInstance, func() {
+		cleanup()
+	}, nil
+}
@@ -1,12 +1,30 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"log"
+	"os"
 
+	internal "github.com/coopnorge/interview-backend/internal/logistics"
 	"github.com/coopnorge/interview-backend/internal/logistics/config"
 )
 
+const (
+	// exitCodeFailed when run could not start or finished with error
+	exitCodeFailed = 1
+	// exitCodeSLOViolated when run finished but any configured SLO failed
+	exitCodeSLOViolated = 2
+	// exitCodeInterrupted when run was stopped by signal, like shell reports process killed by SIGINT
+	exitCodeInterrupted = 130
+)
+
 func main() {
+	os.Exit(run())
+}
+
+// run client and return process exit code
+func run() int {
 	cfg := &config.ClientAppConfig{}
 	cfg.LoadFromEnv()
 
@@ -14,12 +32,25 @@ func main() {
 
 	app, cleanup, err := newWire(cfg)
 	if err != nil {
-		panic(err)
+		log.Println(err)
+		return exitCodeFailed
 	}
 	defer cleanup()
 
-	// start and wait for stop signal
-	if e := app.Run(); e != nil {
-		panic(e)
+	defer app.StopOnSignal()()
+
+	// run until world is delivered, load ends or stop signal
+	if _, e := app.Run(context.Background()); e != nil {
+		log.Println(e)
+		switch {
+		case errors.Is(e, internal.ErrInterrupted):
+			return exitCodeInterrupted
+		case errors.Is(e, internal.ErrSLOViolated):
+			return exitCodeSLOViolated
+		default:
+			return exitCodeFailed
+		}
 	}
+
+	return 0
 }
@@ -17,9 +17,13 @@ import (
 
 // newWire create new DI
 func newWire(cfg *config.ClientAppConfig) (*internal.ServiceInstance, func(), error) {
-	apiLogisticsClient := client.NewLogisticsClient(cfg)
-	worldOperator := operator.NewWorldOperator()
-	serviceInstance, err := internal.NewServiceInstance(apiLogisticsClient, worldOperator, cfg)
+	apiLogisticsClient, err := client.NewLogisticsClient(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	rand := operator.NewRandom(cfg)
+	worldOperator := operator.NewWorldOperator(rand)
+	serviceInstance, err := internal.NewServiceInstance(apiLogisticsClient, worldOperator, rand, cfg)
 	if err != nil {
 		return nil, nil, err
 	}
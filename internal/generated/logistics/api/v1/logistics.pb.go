@@ -11,6 +11,8 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -30,6 +32,16 @@ type MoveUnitRequest struct {
 
 	CargoUnitId int64     `protobuf:"varint,1,opt,name=cargo_unit_id,json=cargoUnitId,proto3" json:"cargo_unit_id,omitempty"`
 	Location    *Location `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	// sequence increases by one with every MoveUnitRequest and UnitReachedWarehouseRequest of the cargo unit,
+	// starts from 1, zero means sequence is not tracked by sender
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// event_time when cargo unit moved
+	EventTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
+	// idempotency_key is the same for every retry of the request, servers may use it to apply request only once
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// run_id identifies client run the request belongs to, sequences and received counts are scoped to run,
+	// empty run_id is shared by every sender that does not set it
+	RunId string `protobuf:"bytes,6,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 }
 
 func (x *MoveUnitRequest) Reset() {
@@ -78,6 +90,34 @@ func (x *MoveUnitRequest) GetLocation() *Location {
 	return nil
 }
 
+func (x *MoveUnitRequest) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *MoveUnitRequest) GetEventTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventTime
+	}
+	return nil
+}
+
+func (x *MoveUnitRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *MoveUnitRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
 // UnitReachedWarehouseRequest contains WarehouseAnnouncement with Location
 type UnitReachedWarehouseRequest struct {
 	state         protoimpl.MessageState
@@ -86,6 +126,14 @@ type UnitReachedWarehouseRequest struct {
 
 	Location     *Location              `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
 	Announcement *WarehouseAnnouncement `protobuf:"bytes,2,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	// sequence of the cargo unit shared with MoveUnitRequest, see MoveUnitRequest.sequence
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// event_time when cargo unit reached warehouse
+	EventTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
+	// idempotency_key is the same for every retry of the request, see MoveUnitRequest.idempotency_key
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// run_id of client run, see MoveUnitRequest.run_id
+	RunId string `protobuf:"bytes,6,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 }
 
 func (x *UnitReachedWarehouseRequest) Reset() {
@@ -115,49 +163,704 @@ func (x *UnitReachedWarehouseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnitReachedWarehouseRequest.ProtoReflect.Descriptor instead.
-func (*UnitReachedWarehouseRequest) Descriptor() ([]byte, []int) {
-	return file_v1_logistics_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use UnitReachedWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*UnitReachedWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_v1_logistics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UnitReachedWarehouseRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *UnitReachedWarehouseRequest) GetAnnouncement() *WarehouseAnnouncement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+func (x *UnitReachedWarehouseRequest) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *UnitReachedWarehouseRequest) GetEventTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventTime
+	}
+	return nil
+}
+
+func (x *UnitReachedWarehouseRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *UnitReachedWarehouseRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+// ExportWarehouseSuppliersRequest
+type ExportWarehouseSuppliersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// run_id exports only suppliers of given client run, see MoveUnitRequest.run_id, all runs if empty
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+}
+
+func (x *ExportWarehouseSuppliersRequest) Reset() {
+	*x = ExportWarehouseSuppliersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_logistics_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportWarehouseSuppliersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportWarehouseSuppliersRequest) ProtoMessage() {}
+
+func (x *ExportWarehouseSuppliersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_logistics_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportWarehouseSuppliersRequest.ProtoReflect.Descriptor instead.
+func (*ExportWarehouseSuppliersRequest) Descriptor() ([]byte, []int) {
+	return file_v1_logistics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExportWarehouseSuppliersRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+// GetReceivedCountsRequest
+type GetReceivedCountsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// run_id of client run to report counts of, see MoveUnitRequest.run_id
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+}
+
+func (x *GetReceivedCountsRequest) Reset() {
+	*x = GetReceivedCountsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_logistics_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReceivedCountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceivedCountsRequest) ProtoMessage() {}
+
+func (x *GetReceivedCountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_logistics_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceivedCountsRequest.ProtoReflect.Descriptor instead.
+func (*GetReceivedCountsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_logistics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetReceivedCountsRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+// WatchUnitMovementsRequest with optional filters, all events are streamed if no filter is set
+type WatchUnitMovementsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cargo_unit_id streams only events of given cargo unit
+	CargoUnitId *int64 `protobuf:"varint,1,opt,name=cargo_unit_id,json=cargoUnitId,proto3,oneof" json:"cargo_unit_id,omitempty"`
+	// warehouse_id streams only announcements of units that reached given warehouse
+	WarehouseId *int64 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3,oneof" json:"warehouse_id,omitempty"`
+}
+
+func (x *WatchUnitMovementsRequest) Reset() {
+	*x = WatchUnitMovementsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_logistics_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchUnitMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchUnitMovementsRequest) ProtoMessage() {}
+
+func (x *WatchUnitMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_logistics_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchUnitMovementsRequest.ProtoReflect.Descriptor instead.
+func (*WatchUnitMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_logistics_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WatchUnitMovementsRequest) GetCargoUnitId() int64 {
+	if x != nil && x.CargoUnitId != nil {
+		return *x.CargoUnitId
+	}
+	return 0
+}
+
+func (x *WatchUnitMovementsRequest) GetWarehouseId() int64 {
+	if x != nil && x.WarehouseId != nil {
+		return *x.WarehouseId
+	}
+	return 0
+}
+
+// DefaultResponse
+type DefaultResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DefaultResponse) Reset() {
+	*x = DefaultResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_logistics_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DefaultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefaultResponse) ProtoMessage() {}
+
+func (x *DefaultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_logistics_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefaultResponse.ProtoReflect.Descriptor instead.
+func (*DefaultResponse) Descriptor() ([]byte, []int) {
+	return file_v1_logistics_proto_rawDescGZIP(), []int{5}
+}
+
+// MoveUnitsResponse acknowledges messages received over MoveUnits stream
+type MoveUnitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// accepted_count is number of MoveUnitRequest accepted from the stream
+	AcceptedCount uint64 `protobuf:"varint,1,opt,name=accepted_count,json=acceptedCount,proto3" json:"accepted_count,omitempty"`
+	// rejected_count is number of MoveUnitRequest rejected as invalid or not stored
+	RejectedCount uint64 `protobuf:"varint,2,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
+}
+
+func (x *MoveUnitsResponse) Reset() {
+	*x = MoveUnitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_logistics_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveUnitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveUnitsResponse) ProtoMessage() {}
+
+func (x *MoveUnitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_logistics_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveUnitsResponse.ProtoReflect.Descriptor instead.
+func (*MoveUnitsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_logistics_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MoveUnitsResponse) GetAcceptedCount() uint64 {
+	if x != nil {
+		return x.AcceptedCount
+	}
+	return 0
+}
+
+func (x *MoveUnitsResponse) GetRejectedCount() uint64 {
+	if x != nil {
+		return x.RejectedCount
+	}
+	return 0
+}
+
+// ExportWarehouseSuppliersResponse contains every warehouse that received supplies
+type ExportWarehouseSuppliersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Warehouses []*WarehouseSuppliers `protobuf:"bytes,1,rep,name=warehouses,proto3" json:"warehouses,omitempty"`
+}
+
+func (x *ExportWarehouseSuppliersResponse) Reset() {
+	*x = ExportWarehouseSuppliersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_logistics_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportWarehouseSuppliersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportWarehouseSuppliersResponse) ProtoMessage() {}
+
+func (x *ExportWarehouseSuppliersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_logistics_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportWarehouseSuppliersResponse.ProtoReflect.Descriptor instead.
+func (*ExportWarehouseSuppliersResponse) Descriptor() ([]byte, []int) {
+	return file_v1_logistics_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExportWarehouseSuppliersResponse) GetWarehouses() []*WarehouseSuppliers {
+	if x != nil {
+		return x.Warehouses
+	}
+	return nil
+}
+
+// GetReceivedCountsResponse contains counts of every cargo unit server received messages from
+type GetReceivedCountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CargoUnits []*CargoUnitReceivedCounts `protobuf:"bytes,1,rep,name=cargo_units,json=cargoUnits,proto3" json:"cargo_units,omitempty"`
+}
+
+func (x *GetReceivedCountsResponse) Reset() {
+	*x = GetReceivedCountsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_logistics_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReceivedCountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceivedCountsResponse) ProtoMessage() {}
+
+func (x *GetReceivedCountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_logistics_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceivedCountsResponse.ProtoReflect.Descriptor instead.
+func (*GetReceivedCountsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_logistics_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetReceivedCountsResponse) GetCargoUnits() []*CargoUnitReceivedCounts {
+	if x != nil {
+		return x.CargoUnits
+	}
+	return nil
+}
+
+// UnitMovementEvent is accepted request of cargo unit
+type UnitMovementEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*UnitMovementEvent_Moved
+	//	*UnitMovementEvent_Reached
+	Event isUnitMovementEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *UnitMovementEvent) Reset() {
+	*x = UnitMovementEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_logistics_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnitMovementEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnitMovementEvent) ProtoMessage() {}
+
+func (x *UnitMovementEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_logistics_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnitMovementEvent.ProtoReflect.Descriptor instead.
+func (*UnitMovementEvent) Descriptor() ([]byte, []int) {
+	return file_v1_logistics_proto_rawDescGZIP(), []int{9}
+}
+
+func (m *UnitMovementEvent) GetEvent() isUnitMovementEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *UnitMovementEvent) GetMoved() *MoveUnitRequest {
+	if x, ok := x.GetEvent().(*UnitMovementEvent_Moved); ok {
+		return x.Moved
+	}
+	return nil
+}
+
+func (x *UnitMovementEvent) GetReached() *UnitReachedWarehouseRequest {
+	if x, ok := x.GetEvent().(*UnitMovementEvent_Reached); ok {
+		return x.Reached
+	}
+	return nil
+}
+
+type isUnitMovementEvent_Event interface {
+	isUnitMovementEvent_Event()
+}
+
+type UnitMovementEvent_Moved struct {
+	Moved *MoveUnitRequest `protobuf:"bytes,1,opt,name=moved,proto3,oneof"`
+}
+
+type UnitMovementEvent_Reached struct {
+	Reached *UnitReachedWarehouseRequest `protobuf:"bytes,2,opt,name=reached,proto3,oneof"`
+}
+
+func (*UnitMovementEvent_Moved) isUnitMovementEvent_Event() {}
+
+func (*UnitMovementEvent_Reached) isUnitMovementEvent_Event() {}
+
+// WarehouseAnnouncement
+type WarehouseAnnouncement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cargo_unit_id is unique id
+	CargoUnitId int64 `protobuf:"varint,1,opt,name=cargo_unit_id,json=cargoUnitId,proto3" json:"cargo_unit_id,omitempty"`
+	// warehouse_id is unique id
+	WarehouseId int64 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	// the message contains information about the announcement
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *WarehouseAnnouncement) Reset() {
+	*x = WarehouseAnnouncement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_logistics_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WarehouseAnnouncement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseAnnouncement) ProtoMessage() {}
+
+func (x *WarehouseAnnouncement) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_logistics_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseAnnouncement.ProtoReflect.Descriptor instead.
+func (*WarehouseAnnouncement) Descriptor() ([]byte, []int) {
+	return file_v1_logistics_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WarehouseAnnouncement) GetCargoUnitId() int64 {
+	if x != nil {
+		return x.CargoUnitId
+	}
+	return 0
+}
+
+func (x *WarehouseAnnouncement) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *WarehouseAnnouncement) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Location where entity now located in X,Y Axis
+type Location struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  uint32 `protobuf:"varint,1,opt,name=Latitude,proto3" json:"Latitude,omitempty"`
+	Longitude uint32 `protobuf:"varint,2,opt,name=Longitude,proto3" json:"Longitude,omitempty"`
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_logistics_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_logistics_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_v1_logistics_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Location) GetLatitude() uint32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Location) GetLongitude() uint32 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+// WarehouseSuppliers is warehouse with cargo units that delivered to it
+type WarehouseSuppliers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// warehouse_id is unique id
+	WarehouseId int64 `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	// delivery_count is number of cargo units that reached warehouse
+	DeliveryCount uint32 `protobuf:"varint,2,opt,name=delivery_count,json=deliveryCount,proto3" json:"delivery_count,omitempty"`
+	// suppliers are cargo units that reached warehouse
+	Suppliers []*Supplier `protobuf:"bytes,3,rep,name=suppliers,proto3" json:"suppliers,omitempty"`
+}
+
+func (x *WarehouseSuppliers) Reset() {
+	*x = WarehouseSuppliers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_logistics_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WarehouseSuppliers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseSuppliers) ProtoMessage() {}
+
+func (x *WarehouseSuppliers) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_logistics_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseSuppliers.ProtoReflect.Descriptor instead.
+func (*WarehouseSuppliers) Descriptor() ([]byte, []int) {
+	return file_v1_logistics_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *UnitReachedWarehouseRequest) GetLocation() *Location {
+func (x *WarehouseSuppliers) GetWarehouseId() int64 {
 	if x != nil {
-		return x.Location
+		return x.WarehouseId
 	}
-	return nil
+	return 0
 }
 
-func (x *UnitReachedWarehouseRequest) GetAnnouncement() *WarehouseAnnouncement {
+func (x *WarehouseSuppliers) GetDeliveryCount() uint32 {
 	if x != nil {
-		return x.Announcement
+		return x.DeliveryCount
+	}
+	return 0
+}
+
+func (x *WarehouseSuppliers) GetSuppliers() []*Supplier {
+	if x != nil {
+		return x.Suppliers
 	}
 	return nil
 }
 
-// DefaultResponse
-type DefaultResponse struct {
+// Supplier is cargo unit that delivered to warehouse
+type Supplier struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// cargo_unit_id is unique id
+	CargoUnitId int64 `protobuf:"varint,1,opt,name=cargo_unit_id,json=cargoUnitId,proto3" json:"cargo_unit_id,omitempty"`
+	// path_length is number of locations cargo unit reported on the way to warehouse
+	PathLength uint32 `protobuf:"varint,2,opt,name=path_length,json=pathLength,proto3" json:"path_length,omitempty"`
+	// run_id of client run that cargo unit belongs to, cargo unit IDs start over in every run
+	RunId string `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 }
 
-func (x *DefaultResponse) Reset() {
-	*x = DefaultResponse{}
+func (x *Supplier) Reset() {
+	*x = Supplier{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_logistics_proto_msgTypes[2]
+		mi := &file_v1_logistics_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DefaultResponse) String() string {
+func (x *Supplier) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DefaultResponse) ProtoMessage() {}
+func (*Supplier) ProtoMessage() {}
 
-func (x *DefaultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_logistics_proto_msgTypes[2]
+func (x *Supplier) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_logistics_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -168,42 +871,67 @@ func (x *DefaultResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DefaultResponse.ProtoReflect.Descriptor instead.
-func (*DefaultResponse) Descriptor() ([]byte, []int) {
-	return file_v1_logistics_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Supplier.ProtoReflect.Descriptor instead.
+func (*Supplier) Descriptor() ([]byte, []int) {
+	return file_v1_logistics_proto_rawDescGZIP(), []int{13}
 }
 
-// WarehouseAnnouncement
-type WarehouseAnnouncement struct {
+func (x *Supplier) GetCargoUnitId() int64 {
+	if x != nil {
+		return x.CargoUnitId
+	}
+	return 0
+}
+
+func (x *Supplier) GetPathLength() uint32 {
+	if x != nil {
+		return x.PathLength
+	}
+	return 0
+}
+
+func (x *Supplier) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+// CargoUnitReceivedCounts of messages accepted by server for single cargo unit
+type CargoUnitReceivedCounts struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// cargo_unit_id is unique id
 	CargoUnitId int64 `protobuf:"varint,1,opt,name=cargo_unit_id,json=cargoUnitId,proto3" json:"cargo_unit_id,omitempty"`
-	// warehouse_id is unique id
-	WarehouseId int64 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
-	// the message contains information about the announcement
-	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// move_unit_count is number of accepted MoveUnitRequest, including ones from MoveUnits stream
+	MoveUnitCount uint64 `protobuf:"varint,2,opt,name=move_unit_count,json=moveUnitCount,proto3" json:"move_unit_count,omitempty"`
+	// unit_reached_warehouse_count is number of accepted UnitReachedWarehouseRequest
+	UnitReachedWarehouseCount uint64 `protobuf:"varint,3,opt,name=unit_reached_warehouse_count,json=unitReachedWarehouseCount,proto3" json:"unit_reached_warehouse_count,omitempty"`
+	// out_of_order_count is number of messages that arrived after message with higher sequence
+	OutOfOrderCount uint64 `protobuf:"varint,4,opt,name=out_of_order_count,json=outOfOrderCount,proto3" json:"out_of_order_count,omitempty"`
+	// duplicate_count is number of messages with already received sequence
+	DuplicateCount uint64 `protobuf:"varint,5,opt,name=duplicate_count,json=duplicateCount,proto3" json:"duplicate_count,omitempty"`
 }
 
-func (x *WarehouseAnnouncement) Reset() {
-	*x = WarehouseAnnouncement{}
+func (x *CargoUnitReceivedCounts) Reset() {
+	*x = CargoUnitReceivedCounts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_logistics_proto_msgTypes[3]
+		mi := &file_v1_logistics_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WarehouseAnnouncement) String() string {
+func (x *CargoUnitReceivedCounts) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WarehouseAnnouncement) ProtoMessage() {}
+func (*CargoUnitReceivedCounts) ProtoMessage() {}
 
-func (x *WarehouseAnnouncement) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_logistics_proto_msgTypes[3]
+func (x *CargoUnitReceivedCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_logistics_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -214,59 +942,83 @@ func (x *WarehouseAnnouncement) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WarehouseAnnouncement.ProtoReflect.Descriptor instead.
-func (*WarehouseAnnouncement) Descriptor() ([]byte, []int) {
-	return file_v1_logistics_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use CargoUnitReceivedCounts.ProtoReflect.Descriptor instead.
+func (*CargoUnitReceivedCounts) Descriptor() ([]byte, []int) {
+	return file_v1_logistics_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *WarehouseAnnouncement) GetCargoUnitId() int64 {
+func (x *CargoUnitReceivedCounts) GetCargoUnitId() int64 {
 	if x != nil {
 		return x.CargoUnitId
 	}
 	return 0
 }
 
-func (x *WarehouseAnnouncement) GetWarehouseId() int64 {
+func (x *CargoUnitReceivedCounts) GetMoveUnitCount() uint64 {
 	if x != nil {
-		return x.WarehouseId
+		return x.MoveUnitCount
 	}
 	return 0
 }
 
-func (x *WarehouseAnnouncement) GetMessage() string {
+func (x *CargoUnitReceivedCounts) GetUnitReachedWarehouseCount() uint64 {
 	if x != nil {
-		return x.Message
+		return x.UnitReachedWarehouseCount
 	}
-	return ""
+	return 0
 }
 
-// Location where entity now located in X,Y Axis
-type Location struct {
+func (x *CargoUnitReceivedCounts) GetOutOfOrderCount() uint64 {
+	if x != nil {
+		return x.OutOfOrderCount
+	}
+	return 0
+}
+
+func (x *CargoUnitReceivedCounts) GetDuplicateCount() uint64 {
+	if x != nil {
+		return x.DuplicateCount
+	}
+	return 0
+}
+
+// TrafficRecord of single request sent by client, used to record and replay client traffic
+type TrafficRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Latitude  uint32 `protobuf:"varint,1,opt,name=Latitude,proto3" json:"Latitude,omitempty"`
-	Longitude uint32 `protobuf:"varint,2,opt,name=Longitude,proto3" json:"Longitude,omitempty"`
+	// offset of send time from the first recorded request
+	Offset *durationpb.Duration `protobuf:"bytes,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// transport name that request was sent with, like gRPC or HTTP
+	Transport string `protobuf:"bytes,2,opt,name=transport,proto3" json:"transport,omitempty"`
+	// Types that are assignable to Request:
+	//	*TrafficRecord_MoveUnit
+	//	*TrafficRecord_UnitReachedWarehouse
+	Request isTrafficRecord_Request `protobuf_oneof:"request"`
+	// latency of request including retries
+	Latency *durationpb.Duration `protobuf:"bytes,5,opt,name=latency,proto3" json:"latency,omitempty"`
+	// error of failed request, empty on success
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *Location) Reset() {
-	*x = Location{}
+func (x *TrafficRecord) Reset() {
+	*x = TrafficRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_logistics_proto_msgTypes[4]
+		mi := &file_v1_logistics_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Location) String() string {
+func (x *TrafficRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Location) ProtoMessage() {}
+func (*TrafficRecord) ProtoMessage() {}
 
-func (x *Location) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_logistics_proto_msgTypes[4]
+func (x *TrafficRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_logistics_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -277,25 +1029,76 @@ func (x *Location) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Location.ProtoReflect.Descriptor instead.
-func (*Location) Descriptor() ([]byte, []int) {
-	return file_v1_logistics_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use TrafficRecord.ProtoReflect.Descriptor instead.
+func (*TrafficRecord) Descriptor() ([]byte, []int) {
+	return file_v1_logistics_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Location) GetLatitude() uint32 {
+func (x *TrafficRecord) GetOffset() *durationpb.Duration {
 	if x != nil {
-		return x.Latitude
+		return x.Offset
 	}
-	return 0
+	return nil
 }
 
-func (x *Location) GetLongitude() uint32 {
+func (x *TrafficRecord) GetTransport() string {
 	if x != nil {
-		return x.Longitude
+		return x.Transport
 	}
-	return 0
+	return ""
+}
+
+func (m *TrafficRecord) GetRequest() isTrafficRecord_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (x *TrafficRecord) GetMoveUnit() *MoveUnitRequest {
+	if x, ok := x.GetRequest().(*TrafficRecord_MoveUnit); ok {
+		return x.MoveUnit
+	}
+	return nil
+}
+
+func (x *TrafficRecord) GetUnitReachedWarehouse() *UnitReachedWarehouseRequest {
+	if x, ok := x.GetRequest().(*TrafficRecord_UnitReachedWarehouse); ok {
+		return x.UnitReachedWarehouse
+	}
+	return nil
+}
+
+func (x *TrafficRecord) GetLatency() *durationpb.Duration {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *TrafficRecord) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type isTrafficRecord_Request interface {
+	isTrafficRecord_Request()
+}
+
+type TrafficRecord_MoveUnit struct {
+	MoveUnit *MoveUnitRequest `protobuf:"bytes,3,opt,name=move_unit,json=moveUnit,proto3,oneof"`
 }
 
+type TrafficRecord_UnitReachedWarehouse struct {
+	UnitReachedWarehouse *UnitReachedWarehouseRequest `protobuf:"bytes,4,opt,name=unit_reached_warehouse,json=unitReachedWarehouse,proto3,oneof"`
+}
+
+func (*TrafficRecord_MoveUnit) isTrafficRecord_Request() {}
+
+func (*TrafficRecord_UnitReachedWarehouse) isTrafficRecord_Request() {}
+
 var File_v1_logistics_proto protoreflect.FileDescriptor
 
 var file_v1_logistics_proto_rawDesc = []byte{
@@ -303,81 +1106,249 @@ var file_v1_logistics_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x63, 0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2e,
 	0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
 	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61,
-	0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x77,
-	0x0a, 0x0f, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x55,
-	0x6e, 0x69, 0x74, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x6e, 0x6f,
-	0x72, 0x67, 0x65, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb6, 0x01, 0x0a, 0x1b, 0x55, 0x6e, 0x69, 0x74,
-	0x52, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6f, 0x70,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x8e, 0x02, 0x0a, 0x0f, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x67,
+	0x6f, 0x55, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6f, 0x70,
 	0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e,
 	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x0c, 0x61, 0x6e, 0x6e,
-	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x31, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x6c, 0x6f, 0x67, 0x69,
-	0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x72,
-	0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x22, 0x11, 0x0a, 0x0f, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x78, 0x0a, 0x15, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65,
-	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d,
-	0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x49, 0x64,
-	0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73,
-	0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x44, 0x0a,
-	0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x61, 0x74,
-	0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x4c, 0x61, 0x74,
-	0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
-	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
-	0x75, 0x64, 0x65, 0x32, 0xc5, 0x02, 0x0a, 0x16, 0x43, 0x6f, 0x6f, 0x70, 0x4c, 0x6f, 0x67, 0x69,
-	0x73, 0x74, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x41, 0x50, 0x49, 0x12, 0x81,
-	0x01, 0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x2b, 0x2e, 0x63, 0x6f,
-	0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63,
-	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x6e,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64,
+	0x22, 0xcd, 0x02, 0x0a, 0x1b, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64,
+	0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x40, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x6c,
+	0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x6e,
 	0x6f, 0x72, 0x67, 0x65, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x13, 0x2f,
-	0x76, 0x31, 0x2f, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x2f, 0x6d, 0x6f,
-	0x76, 0x65, 0x12, 0xa6, 0x01, 0x0a, 0x14, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68,
-	0x65, 0x64, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x37, 0x2e, 0x63, 0x6f,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64,
+	0x22, 0x38, 0x0a, 0x1f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f,
+	0x75, 0x73, 0x65, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x22, 0x8f, 0x01,
+	0x0a, 0x19, 0x57, 0x61, 0x74, 0x63, 0x68, 0x55, 0x6e, 0x69, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0d, 0x63,
+	0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0b, 0x77, 0x61,
+	0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x22,
+	0x11, 0x0a, 0x0f, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x61, 0x0a, 0x11, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x72, 0x0a, 0x20, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57,
+	0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0a, 0x77, 0x61, 0x72,
+	0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x63, 0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x72, 0x65, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x52, 0x0a, 0x77,
+	0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x22, 0x71, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f,
+	0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x6f,
 	0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63,
-	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x61,
-	0x63, 0x68, 0x65, 0x64, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65,
+	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e,
+	0x69, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x52, 0x0a, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x22, 0xb6, 0x01, 0x0a,
+	0x11, 0x55, 0x6e, 0x69, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x43, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x6c, 0x6f,
+	0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x53, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x63, 0x68,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x6e,
+	0x6f, 0x72, 0x67, 0x65, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x65,
+	0x64, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x78, 0x0a, 0x15, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22,
+	0x0a, 0x0d, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f,
+	0x75, 0x73, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x44, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x4c,
+	0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x4c,
+	0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x6f, 0x6e, 0x67, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x4c, 0x6f, 0x6e, 0x67,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0xa2, 0x01, 0x0a, 0x12, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f,
+	0x75, 0x73, 0x65, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x64, 0x12,
+	0x25, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x6f, 0x70,
+	0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x52,
+	0x09, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x22, 0x66, 0x0a, 0x08, 0x53, 0x75,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f,
+	0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63,
+	0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61,
+	0x74, 0x68, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x70, 0x61, 0x74, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x15, 0x0a, 0x06, 0x72,
+	0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e,
+	0x49, 0x64, 0x22, 0xfc, 0x01, 0x0a, 0x17, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x22,
+	0x0a, 0x0d, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x55, 0x6e, 0x69, 0x74,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x6f, 0x76,
+	0x65, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x1c, 0x75, 0x6e,
+	0x69, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x77, 0x61, 0x72, 0x65, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x19, 0x75, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x57, 0x61, 0x72,
+	0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x6f,
+	0x75, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x4f, 0x66, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x75, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0xf3, 0x02, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x4a, 0x0a, 0x09, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x6e, 0x6f,
+	0x72, 0x67, 0x65, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69, 0x74,
+	0x12, 0x6f, 0x0a, 0x16, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64,
+	0x5f, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x37, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x6c, 0x6f, 0x67,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e,
+	0x69, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x14, 0x75, 0x6e, 0x69,
+	0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73,
+	0x65, 0x12, 0x33, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x09, 0x0a, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0xd3, 0x07, 0x0a, 0x16, 0x43, 0x6f, 0x6f, 0x70,
+	0x4c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x41,
+	0x50, 0x49, 0x12, 0x9b, 0x01, 0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12,
+	0x2b, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x6c, 0x6f, 0x67, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76,
+	0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63,
+	0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2f, 0x5a, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x72, 0x67,
+	0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x3a, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x13, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x2f, 0x6d, 0x6f, 0x76, 0x65,
+	0x12, 0xcd, 0x01, 0x0a, 0x14, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64,
+	0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x37, 0x2e, 0x63, 0x6f, 0x6f, 0x70,
+	0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68,
+	0x65, 0x64, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x6c,
+	0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x4f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x49, 0x5a, 0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x76,
+	0x31, 0x2f, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x63, 0x61, 0x72, 0x67,
+	0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x3a, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x22, 0x20,
+	0x2f, 0x76, 0x31, 0x2f, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x63, 0x61,
+	0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x2f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64,
+	0x12, 0xb6, 0x01, 0x0a, 0x18, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x61, 0x72, 0x65, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x12, 0x3b, 0x2e,
+	0x63, 0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x57, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x63, 0x6f, 0x6f,
+	0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x61,
+	0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
+	0x12, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f,
+	0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x12, 0x7c, 0x0a, 0x12, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x55, 0x6e, 0x69, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x35, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x6c, 0x6f, 0x67, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x55, 0x6e, 0x69, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72,
+	0x67, 0x65, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x69, 0x0a, 0x09, 0x4d, 0x6f, 0x76, 0x65, 0x55,
+	0x6e, 0x69, 0x74, 0x73, 0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65,
 	0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x77,
-	0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75,
-	0x6e, 0x69, 0x74, 0x2f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x42, 0x8d, 0x02, 0x0a, 0x1e,
-	0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x6c, 0x6f,
-	0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x0e,
-	0x4c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x50, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6f,
-	0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77,
-	0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x6c, 0x6f, 0x67, 0x69,
-	0x73, 0x74, 0x69, 0x63, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x70, 0x69,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x4c, 0x41, 0xaa, 0x02, 0x1a, 0x43, 0x6f, 0x6f, 0x70, 0x6e,
-	0x6f, 0x72, 0x67, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41,
-	0x70, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x43, 0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67,
-	0x65, 0x5c, 0x4c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x5c, 0x41, 0x70, 0x69, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x26, 0x43, 0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x5c, 0x4c,
-	0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1d, 0x43, 0x6f,
-	0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x3a, 0x3a, 0x4c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69,
-	0x63, 0x73, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2d, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x6c, 0x6f,
+	0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x6f, 0x76, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x28, 0x01, 0x12, 0xa8, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x34, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x6e,
+	0x6f, 0x72, 0x67, 0x65, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35,
+	0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x6c, 0x6f, 0x67, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x2f, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x8d, 0x02,
+	0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2e,
+	0x6c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x42, 0x0e, 0x4c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x50, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x69,
+	0x65, 0x77, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x6c, 0x6f,
+	0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x61,
+	0x70, 0x69, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x4c, 0x41, 0xaa, 0x02, 0x1a, 0x43, 0x6f, 0x6f,
+	0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1a, 0x43, 0x6f, 0x6f, 0x70, 0x6e, 0x6f,
+	0x72, 0x67, 0x65, 0x5c, 0x4c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x5c, 0x41, 0x70,
+	0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x26, 0x43, 0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65,
+	0x5c, 0x4c, 0x6f, 0x67, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1d,
+	0x43, 0x6f, 0x6f, 0x70, 0x6e, 0x6f, 0x72, 0x67, 0x65, 0x3a, 0x3a, 0x4c, 0x6f, 0x67, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -392,27 +1363,59 @@ func file_v1_logistics_proto_rawDescGZIP() []byte {
 	return file_v1_logistics_proto_rawDescData
 }
 
-var file_v1_logistics_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_v1_logistics_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_v1_logistics_proto_goTypes = []interface{}{
-	(*MoveUnitRequest)(nil),             // 0: coopnorge.logistics.api.v1.MoveUnitRequest
-	(*UnitReachedWarehouseRequest)(nil), // 1: coopnorge.logistics.api.v1.UnitReachedWarehouseRequest
-	(*DefaultResponse)(nil),             // 2: coopnorge.logistics.api.v1.DefaultResponse
-	(*WarehouseAnnouncement)(nil),       // 3: coopnorge.logistics.api.v1.WarehouseAnnouncement
-	(*Location)(nil),                    // 4: coopnorge.logistics.api.v1.Location
+	(*MoveUnitRequest)(nil),                  // 0: coopnorge.logistics.api.v1.MoveUnitRequest
+	(*UnitReachedWarehouseRequest)(nil),      // 1: coopnorge.logistics.api.v1.UnitReachedWarehouseRequest
+	(*ExportWarehouseSuppliersRequest)(nil),  // 2: coopnorge.logistics.api.v1.ExportWarehouseSuppliersRequest
+	(*GetReceivedCountsRequest)(nil),         // 3: coopnorge.logistics.api.v1.GetReceivedCountsRequest
+	(*WatchUnitMovementsRequest)(nil),        // 4: coopnorge.logistics.api.v1.WatchUnitMovementsRequest
+	(*DefaultResponse)(nil),                  // 5: coopnorge.logistics.api.v1.DefaultResponse
+	(*MoveUnitsResponse)(nil),                // 6: coopnorge.logistics.api.v1.MoveUnitsResponse
+	(*ExportWarehouseSuppliersResponse)(nil), // 7: coopnorge.logistics.api.v1.ExportWarehouseSuppliersResponse
+	(*GetReceivedCountsResponse)(nil),        // 8: coopnorge.logistics.api.v1.GetReceivedCountsResponse
+	(*UnitMovementEvent)(nil),                // 9: coopnorge.logistics.api.v1.UnitMovementEvent
+	(*WarehouseAnnouncement)(nil),            // 10: coopnorge.logistics.api.v1.WarehouseAnnouncement
+	(*Location)(nil),                         // 11: coopnorge.logistics.api.v1.Location
+	(*WarehouseSuppliers)(nil),               // 12: coopnorge.logistics.api.v1.WarehouseSuppliers
+	(*Supplier)(nil),                         // 13: coopnorge.logistics.api.v1.Supplier
+	(*CargoUnitReceivedCounts)(nil),          // 14: coopnorge.logistics.api.v1.CargoUnitReceivedCounts
+	(*TrafficRecord)(nil),                    // 15: coopnorge.logistics.api.v1.TrafficRecord
+	(*timestamppb.Timestamp)(nil),            // 16: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),              // 17: google.protobuf.Duration
 }
 var file_v1_logistics_proto_depIdxs = []int32{
-	4, // 0: coopnorge.logistics.api.v1.MoveUnitRequest.location:type_name -> coopnorge.logistics.api.v1.Location
-	4, // 1: coopnorge.logistics.api.v1.UnitReachedWarehouseRequest.location:type_name -> coopnorge.logistics.api.v1.Location
-	3, // 2: coopnorge.logistics.api.v1.UnitReachedWarehouseRequest.announcement:type_name -> coopnorge.logistics.api.v1.WarehouseAnnouncement
-	0, // 3: coopnorge.logistics.api.v1.CoopLogisticsEngineAPI.MoveUnit:input_type -> coopnorge.logistics.api.v1.MoveUnitRequest
-	1, // 4: coopnorge.logistics.api.v1.CoopLogisticsEngineAPI.UnitReachedWarehouse:input_type -> coopnorge.logistics.api.v1.UnitReachedWarehouseRequest
-	2, // 5: coopnorge.logistics.api.v1.CoopLogisticsEngineAPI.MoveUnit:output_type -> coopnorge.logistics.api.v1.DefaultResponse
-	2, // 6: coopnorge.logistics.api.v1.CoopLogisticsEngineAPI.UnitReachedWarehouse:output_type -> coopnorge.logistics.api.v1.DefaultResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	11, // 0: coopnorge.logistics.api.v1.MoveUnitRequest.location:type_name -> coopnorge.logistics.api.v1.Location
+	16, // 1: coopnorge.logistics.api.v1.MoveUnitRequest.event_time:type_name -> google.protobuf.Timestamp
+	11, // 2: coopnorge.logistics.api.v1.UnitReachedWarehouseRequest.location:type_name -> coopnorge.logistics.api.v1.Location
+	10, // 3: coopnorge.logistics.api.v1.UnitReachedWarehouseRequest.announcement:type_name -> coopnorge.logistics.api.v1.WarehouseAnnouncement
+	16, // 4: coopnorge.logistics.api.v1.UnitReachedWarehouseRequest.event_time:type_name -> google.protobuf.Timestamp
+	12, // 5: coopnorge.logistics.api.v1.ExportWarehouseSuppliersResponse.warehouses:type_name -> coopnorge.logistics.api.v1.WarehouseSuppliers
+	14, // 6: coopnorge.logistics.api.v1.GetReceivedCountsResponse.cargo_units:type_name -> coopnorge.logistics.api.v1.CargoUnitReceivedCounts
+	0,  // 7: coopnorge.logistics.api.v1.UnitMovementEvent.moved:type_name -> coopnorge.logistics.api.v1.MoveUnitRequest
+	1,  // 8: coopnorge.logistics.api.v1.UnitMovementEvent.reached:type_name -> coopnorge.logistics.api.v1.UnitReachedWarehouseRequest
+	13, // 9: coopnorge.logistics.api.v1.WarehouseSuppliers.suppliers:type_name -> coopnorge.logistics.api.v1.Supplier
+	17, // 10: coopnorge.logistics.api.v1.TrafficRecord.offset:type_name -> google.protobuf.Duration
+	0,  // 11: coopnorge.logistics.api.v1.TrafficRecord.move_unit:type_name -> coopnorge.logistics.api.v1.MoveUnitRequest
+	1,  // 12: coopnorge.logistics.api.v1.TrafficRecord.unit_reached_warehouse:type_name -> coopnorge.logistics.api.v1.UnitReachedWarehouseRequest
+	17, // 13: coopnorge.logistics.api.v1.TrafficRecord.latency:type_name -> google.protobuf.Duration
+	0,  // 14: coopnorge.logistics.api.v1.CoopLogisticsEngineAPI.MoveUnit:input_type -> coopnorge.logistics.api.v1.MoveUnitRequest
+	1,  // 15: coopnorge.logistics.api.v1.CoopLogisticsEngineAPI.UnitReachedWarehouse:input_type -> coopnorge.logistics.api.v1.UnitReachedWarehouseRequest
+	2,  // 16: coopnorge.logistics.api.v1.CoopLogisticsEngineAPI.ExportWarehouseSuppliers:input_type -> coopnorge.logistics.api.v1.ExportWarehouseSuppliersRequest
+	4,  // 17: coopnorge.logistics.api.v1.CoopLogisticsEngineAPI.WatchUnitMovements:input_type -> coopnorge.logistics.api.v1.WatchUnitMovementsRequest
+	0,  // 18: coopnorge.logistics.api.v1.CoopLogisticsEngineAPI.MoveUnits:input_type -> coopnorge.logistics.api.v1.MoveUnitRequest
+	3,  // 19: coopnorge.logistics.api.v1.CoopLogisticsEngineAPI.GetReceivedCounts:input_type -> coopnorge.logistics.api.v1.GetReceivedCountsRequest
+	5,  // 20: coopnorge.logistics.api.v1.CoopLogisticsEngineAPI.MoveUnit:output_type -> coopnorge.logistics.api.v1.DefaultResponse
+	5,  // 21: coopnorge.logistics.api.v1.CoopLogisticsEngineAPI.UnitReachedWarehouse:output_type -> coopnorge.logistics.api.v1.DefaultResponse
+	7,  // 22: coopnorge.logistics.api.v1.CoopLogisticsEngineAPI.ExportWarehouseSuppliers:output_type -> coopnorge.logistics.api.v1.ExportWarehouseSuppliersResponse
+	9,  // 23: coopnorge.logistics.api.v1.CoopLogisticsEngineAPI.WatchUnitMovements:output_type -> coopnorge.logistics.api.v1.UnitMovementEvent
+	6,  // 24: coopnorge.logistics.api.v1.CoopLogisticsEngineAPI.MoveUnits:output_type -> coopnorge.logistics.api.v1.MoveUnitsResponse
+	8,  // 25: coopnorge.logistics.api.v1.CoopLogisticsEngineAPI.GetReceivedCounts:output_type -> coopnorge.logistics.api.v1.GetReceivedCountsResponse
+	20, // [20:26] is the sub-list for method output_type
+	14, // [14:20] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_v1_logistics_proto_init() }
@@ -446,7 +1449,7 @@ func file_v1_logistics_proto_init() {
 			}
 		}
 		file_v1_logistics_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefaultResponse); i {
+			switch v := v.(*ExportWarehouseSuppliersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -458,7 +1461,7 @@ func file_v1_logistics_proto_init() {
 			}
 		}
 		file_v1_logistics_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WarehouseAnnouncement); i {
+			switch v := v.(*GetReceivedCountsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -470,6 +1473,90 @@ func file_v1_logistics_proto_init() {
 			}
 		}
 		file_v1_logistics_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchUnitMovementsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_logistics_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DefaultResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_logistics_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveUnitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_logistics_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportWarehouseSuppliersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_logistics_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReceivedCountsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_logistics_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnitMovementEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_logistics_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WarehouseAnnouncement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_logistics_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Location); i {
 			case 0:
 				return &v.state
@@ -481,6 +1568,63 @@ func file_v1_logistics_proto_init() {
 				return nil
 			}
 		}
+		file_v1_logistics_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WarehouseSuppliers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_logistics_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Supplier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_logistics_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CargoUnitReceivedCounts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_logistics_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_v1_logistics_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_v1_logistics_proto_msgTypes[9].OneofWrappers = []interface{}{
+		(*UnitMovementEvent_Moved)(nil),
+		(*UnitMovementEvent_Reached)(nil),
+	}
+	file_v1_logistics_proto_msgTypes[15].OneofWrappers = []interface{}{
+		(*TrafficRecord_MoveUnit)(nil),
+		(*TrafficRecord_UnitReachedWarehouse)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -488,7 +1632,7 @@ func file_v1_logistics_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_logistics_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
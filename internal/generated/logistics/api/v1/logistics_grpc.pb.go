@@ -19,8 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	CoopLogisticsEngineAPI_MoveUnit_FullMethodName             = "/coopnorge.logistics.api.v1.CoopLogisticsEngineAPI/MoveUnit"
-	CoopLogisticsEngineAPI_UnitReachedWarehouse_FullMethodName = "/coopnorge.logistics.api.v1.CoopLogisticsEngineAPI/UnitReachedWarehouse"
+	CoopLogisticsEngineAPI_MoveUnit_FullMethodName                 = "/coopnorge.logistics.api.v1.CoopLogisticsEngineAPI/MoveUnit"
+	CoopLogisticsEngineAPI_UnitReachedWarehouse_FullMethodName     = "/coopnorge.logistics.api.v1.CoopLogisticsEngineAPI/UnitReachedWarehouse"
+	CoopLogisticsEngineAPI_ExportWarehouseSuppliers_FullMethodName = "/coopnorge.logistics.api.v1.CoopLogisticsEngineAPI/ExportWarehouseSuppliers"
+	CoopLogisticsEngineAPI_WatchUnitMovements_FullMethodName       = "/coopnorge.logistics.api.v1.CoopLogisticsEngineAPI/WatchUnitMovements"
+	CoopLogisticsEngineAPI_MoveUnits_FullMethodName                = "/coopnorge.logistics.api.v1.CoopLogisticsEngineAPI/MoveUnits"
+	CoopLogisticsEngineAPI_GetReceivedCounts_FullMethodName        = "/coopnorge.logistics.api.v1.CoopLogisticsEngineAPI/GetReceivedCounts"
 )
 
 // CoopLogisticsEngineAPIClient is the client API for CoopLogisticsEngineAPI service.
@@ -28,9 +32,21 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CoopLogisticsEngineAPIClient interface {
 	// MoveUnit request will be send when unit moves in dimensions to new location.
+	// Over HTTP it is sent as query parameters, or as JSON or binary protobuf body to the additional binding.
 	MoveUnit(ctx context.Context, in *MoveUnitRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	// UnitReachedWarehouse reports when unit reached warehouse to do something there.
+	// Over HTTP it is sent as query parameters, or as JSON or binary protobuf body to the additional binding.
 	UnitReachedWarehouse(ctx context.Context, in *UnitReachedWarehouseRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	// ExportWarehouseSuppliers exports each warehouse with cargo units (suppliers) that delivered to it.
+	ExportWarehouseSuppliers(ctx context.Context, in *ExportWarehouseSuppliersRequest, opts ...grpc.CallOption) (*ExportWarehouseSuppliersResponse, error)
+	// WatchUnitMovements streams every accepted MoveUnit and UnitReachedWarehouse request.
+	// Over HTTP the same feed is served as Server-Sent Events by the reference server.
+	WatchUnitMovements(ctx context.Context, in *WatchUnitMovementsRequest, opts ...grpc.CallOption) (CoopLogisticsEngineAPI_WatchUnitMovementsClient, error)
+	// MoveUnits accepts all unit movements over single long-lived stream,
+	// number of accepted messages is acknowledged when client closes the stream.
+	MoveUnits(ctx context.Context, opts ...grpc.CallOption) (CoopLogisticsEngineAPI_MoveUnitsClient, error)
+	// GetReceivedCounts reports how many messages of each cargo unit server accepted in run since start.
+	GetReceivedCounts(ctx context.Context, in *GetReceivedCountsRequest, opts ...grpc.CallOption) (*GetReceivedCountsResponse, error)
 }
 
 type coopLogisticsEngineAPIClient struct {
@@ -59,14 +75,110 @@ func (c *coopLogisticsEngineAPIClient) UnitReachedWarehouse(ctx context.Context,
 	return out, nil
 }
 
+func (c *coopLogisticsEngineAPIClient) ExportWarehouseSuppliers(ctx context.Context, in *ExportWarehouseSuppliersRequest, opts ...grpc.CallOption) (*ExportWarehouseSuppliersResponse, error) {
+	out := new(ExportWarehouseSuppliersResponse)
+	err := c.cc.Invoke(ctx, CoopLogisticsEngineAPI_ExportWarehouseSuppliers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coopLogisticsEngineAPIClient) WatchUnitMovements(ctx context.Context, in *WatchUnitMovementsRequest, opts ...grpc.CallOption) (CoopLogisticsEngineAPI_WatchUnitMovementsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CoopLogisticsEngineAPI_ServiceDesc.Streams[0], CoopLogisticsEngineAPI_WatchUnitMovements_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &coopLogisticsEngineAPIWatchUnitMovementsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CoopLogisticsEngineAPI_WatchUnitMovementsClient interface {
+	Recv() (*UnitMovementEvent, error)
+	grpc.ClientStream
+}
+
+type coopLogisticsEngineAPIWatchUnitMovementsClient struct {
+	grpc.ClientStream
+}
+
+func (x *coopLogisticsEngineAPIWatchUnitMovementsClient) Recv() (*UnitMovementEvent, error) {
+	m := new(UnitMovementEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *coopLogisticsEngineAPIClient) MoveUnits(ctx context.Context, opts ...grpc.CallOption) (CoopLogisticsEngineAPI_MoveUnitsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CoopLogisticsEngineAPI_ServiceDesc.Streams[1], CoopLogisticsEngineAPI_MoveUnits_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &coopLogisticsEngineAPIMoveUnitsClient{stream}
+	return x, nil
+}
+
+type CoopLogisticsEngineAPI_MoveUnitsClient interface {
+	Send(*MoveUnitRequest) error
+	CloseAndRecv() (*MoveUnitsResponse, error)
+	grpc.ClientStream
+}
+
+type coopLogisticsEngineAPIMoveUnitsClient struct {
+	grpc.ClientStream
+}
+
+func (x *coopLogisticsEngineAPIMoveUnitsClient) Send(m *MoveUnitRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *coopLogisticsEngineAPIMoveUnitsClient) CloseAndRecv() (*MoveUnitsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(MoveUnitsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *coopLogisticsEngineAPIClient) GetReceivedCounts(ctx context.Context, in *GetReceivedCountsRequest, opts ...grpc.CallOption) (*GetReceivedCountsResponse, error) {
+	out := new(GetReceivedCountsResponse)
+	err := c.cc.Invoke(ctx, CoopLogisticsEngineAPI_GetReceivedCounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CoopLogisticsEngineAPIServer is the server API for CoopLogisticsEngineAPI service.
 // All implementations must embed UnimplementedCoopLogisticsEngineAPIServer
 // for forward compatibility
 type CoopLogisticsEngineAPIServer interface {
 	// MoveUnit request will be send when unit moves in dimensions to new location.
+	// Over HTTP it is sent as query parameters, or as JSON or binary protobuf body to the additional binding.
 	MoveUnit(context.Context, *MoveUnitRequest) (*DefaultResponse, error)
 	// UnitReachedWarehouse reports when unit reached warehouse to do something there.
+	// Over HTTP it is sent as query parameters, or as JSON or binary protobuf body to the additional binding.
 	UnitReachedWarehouse(context.Context, *UnitReachedWarehouseRequest) (*DefaultResponse, error)
+	// ExportWarehouseSuppliers exports each warehouse with cargo units (suppliers) that delivered to it.
+	ExportWarehouseSuppliers(context.Context, *ExportWarehouseSuppliersRequest) (*ExportWarehouseSuppliersResponse, error)
+	// WatchUnitMovements streams every accepted MoveUnit and UnitReachedWarehouse request.
+	// Over HTTP the same feed is served as Server-Sent Events by the reference server.
+	WatchUnitMovements(*WatchUnitMovementsRequest, CoopLogisticsEngineAPI_WatchUnitMovementsServer) error
+	// MoveUnits accepts all unit movements over single long-lived stream,
+	// number of accepted messages is acknowledged when client closes the stream.
+	MoveUnits(CoopLogisticsEngineAPI_MoveUnitsServer) error
+	// GetReceivedCounts reports how many messages of each cargo unit server accepted in run since start.
+	GetReceivedCounts(context.Context, *GetReceivedCountsRequest) (*GetReceivedCountsResponse, error)
 	mustEmbedUnimplementedCoopLogisticsEngineAPIServer()
 }
 
@@ -80,6 +192,18 @@ func (UnimplementedCoopLogisticsEngineAPIServer) MoveUnit(context.Context, *Move
 func (UnimplementedCoopLogisticsEngineAPIServer) UnitReachedWarehouse(context.Context, *UnitReachedWarehouseRequest) (*DefaultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnitReachedWarehouse not implemented")
 }
+func (UnimplementedCoopLogisticsEngineAPIServer) ExportWarehouseSuppliers(context.Context, *ExportWarehouseSuppliersRequest) (*ExportWarehouseSuppliersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportWarehouseSuppliers not implemented")
+}
+func (UnimplementedCoopLogisticsEngineAPIServer) WatchUnitMovements(*WatchUnitMovementsRequest, CoopLogisticsEngineAPI_WatchUnitMovementsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchUnitMovements not implemented")
+}
+func (UnimplementedCoopLogisticsEngineAPIServer) MoveUnits(CoopLogisticsEngineAPI_MoveUnitsServer) error {
+	return status.Errorf(codes.Unimplemented, "method MoveUnits not implemented")
+}
+func (UnimplementedCoopLogisticsEngineAPIServer) GetReceivedCounts(context.Context, *GetReceivedCountsRequest) (*GetReceivedCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceivedCounts not implemented")
+}
 func (UnimplementedCoopLogisticsEngineAPIServer) mustEmbedUnimplementedCoopLogisticsEngineAPIServer() {
 }
 
@@ -130,6 +254,89 @@ func _CoopLogisticsEngineAPI_UnitReachedWarehouse_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CoopLogisticsEngineAPI_ExportWarehouseSuppliers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportWarehouseSuppliersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoopLogisticsEngineAPIServer).ExportWarehouseSuppliers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoopLogisticsEngineAPI_ExportWarehouseSuppliers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoopLogisticsEngineAPIServer).ExportWarehouseSuppliers(ctx, req.(*ExportWarehouseSuppliersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoopLogisticsEngineAPI_WatchUnitMovements_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchUnitMovementsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoopLogisticsEngineAPIServer).WatchUnitMovements(m, &coopLogisticsEngineAPIWatchUnitMovementsServer{stream})
+}
+
+type CoopLogisticsEngineAPI_WatchUnitMovementsServer interface {
+	Send(*UnitMovementEvent) error
+	grpc.ServerStream
+}
+
+type coopLogisticsEngineAPIWatchUnitMovementsServer struct {
+	grpc.ServerStream
+}
+
+func (x *coopLogisticsEngineAPIWatchUnitMovementsServer) Send(m *UnitMovementEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CoopLogisticsEngineAPI_MoveUnits_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CoopLogisticsEngineAPIServer).MoveUnits(&coopLogisticsEngineAPIMoveUnitsServer{stream})
+}
+
+type CoopLogisticsEngineAPI_MoveUnitsServer interface {
+	SendAndClose(*MoveUnitsResponse) error
+	Recv() (*MoveUnitRequest, error)
+	grpc.ServerStream
+}
+
+type coopLogisticsEngineAPIMoveUnitsServer struct {
+	grpc.ServerStream
+}
+
+func (x *coopLogisticsEngineAPIMoveUnitsServer) SendAndClose(m *MoveUnitsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *coopLogisticsEngineAPIMoveUnitsServer) Recv() (*MoveUnitRequest, error) {
+	m := new(MoveUnitRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CoopLogisticsEngineAPI_GetReceivedCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceivedCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoopLogisticsEngineAPIServer).GetReceivedCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoopLogisticsEngineAPI_GetReceivedCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoopLogisticsEngineAPIServer).GetReceivedCounts(ctx, req.(*GetReceivedCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CoopLogisticsEngineAPI_ServiceDesc is the grpc.ServiceDesc for CoopLogisticsEngineAPI service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -145,7 +352,26 @@ var CoopLogisticsEngineAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnitReachedWarehouse",
 			Handler:    _CoopLogisticsEngineAPI_UnitReachedWarehouse_Handler,
 		},
+		{
+			MethodName: "ExportWarehouseSuppliers",
+			Handler:    _CoopLogisticsEngineAPI_ExportWarehouseSuppliers_Handler,
+		},
+		{
+			MethodName: "GetReceivedCounts",
+			Handler:    _CoopLogisticsEngineAPI_GetReceivedCounts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchUnitMovements",
+			Handler:       _CoopLogisticsEngineAPI_WatchUnitMovements_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "MoveUnits",
+			Handler:       _CoopLogisticsEngineAPI_MoveUnits_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/logistics.proto",
 }
@@ -16,18 +16,253 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 
 // CoopLogisticsEngineAPIAPIService CoopLogisticsEngineAPIAPI service
 type CoopLogisticsEngineAPIAPIService service
 
+type ApiCoopLogisticsEngineAPIExportWarehouseSuppliersRequest struct {
+	ctx context.Context
+	ApiService *CoopLogisticsEngineAPIAPIService
+	runId *string
+}
+
+// run_id exports only suppliers of given client run, see MoveUnitRequest.run_id, all runs if empty
+func (r ApiCoopLogisticsEngineAPIExportWarehouseSuppliersRequest) RunId(runId string) ApiCoopLogisticsEngineAPIExportWarehouseSuppliersRequest {
+	r.runId = &runId
+	return r
+}
+
+func (r ApiCoopLogisticsEngineAPIExportWarehouseSuppliersRequest) Execute() (*V1ExportWarehouseSuppliersResponse, *http.Response, error) {
+	return r.ApiService.CoopLogisticsEngineAPIExportWarehouseSuppliersExecute(r)
+}
+
+/*
+CoopLogisticsEngineAPIExportWarehouseSuppliers ExportWarehouseSuppliers exports each warehouse with cargo units (suppliers) that delivered to it.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiCoopLogisticsEngineAPIExportWarehouseSuppliersRequest
+*/
+func (a *CoopLogisticsEngineAPIAPIService) CoopLogisticsEngineAPIExportWarehouseSuppliers(ctx context.Context) ApiCoopLogisticsEngineAPIExportWarehouseSuppliersRequest {
+	return ApiCoopLogisticsEngineAPIExportWarehouseSuppliersRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return V1ExportWarehouseSuppliersResponse
+func (a *CoopLogisticsEngineAPIAPIService) CoopLogisticsEngineAPIExportWarehouseSuppliersExecute(r ApiCoopLogisticsEngineAPIExportWarehouseSuppliersRequest) (*V1ExportWarehouseSuppliersResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *V1ExportWarehouseSuppliersResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CoopLogisticsEngineAPIAPIService.CoopLogisticsEngineAPIExportWarehouseSuppliers")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/warehouse/suppliers"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.runId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "runId", r.runId, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v RpcStatus
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCoopLogisticsEngineAPIGetReceivedCountsRequest struct {
+	ctx context.Context
+	ApiService *CoopLogisticsEngineAPIAPIService
+	runId *string
+}
+
+// run_id of client run to report counts of, see MoveUnitRequest.run_id
+func (r ApiCoopLogisticsEngineAPIGetReceivedCountsRequest) RunId(runId string) ApiCoopLogisticsEngineAPIGetReceivedCountsRequest {
+	r.runId = &runId
+	return r
+}
+
+func (r ApiCoopLogisticsEngineAPIGetReceivedCountsRequest) Execute() (*V1GetReceivedCountsResponse, *http.Response, error) {
+	return r.ApiService.CoopLogisticsEngineAPIGetReceivedCountsExecute(r)
+}
+
+/*
+CoopLogisticsEngineAPIGetReceivedCounts GetReceivedCounts reports how many messages of each cargo unit server accepted in run since start.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @return ApiCoopLogisticsEngineAPIGetReceivedCountsRequest
+*/
+func (a *CoopLogisticsEngineAPIAPIService) CoopLogisticsEngineAPIGetReceivedCounts(ctx context.Context) ApiCoopLogisticsEngineAPIGetReceivedCountsRequest {
+	return ApiCoopLogisticsEngineAPIGetReceivedCountsRequest{
+		ApiService: a,
+		ctx: ctx,
+	}
+}
+
+// Execute executes the request
+//  @return V1GetReceivedCountsResponse
+func (a *CoopLogisticsEngineAPIAPIService) CoopLogisticsEngineAPIGetReceivedCountsExecute(r ApiCoopLogisticsEngineAPIGetReceivedCountsRequest) (*V1GetReceivedCountsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *V1GetReceivedCountsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CoopLogisticsEngineAPIAPIService.CoopLogisticsEngineAPIGetReceivedCounts")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/v1/cargo_unit/received_counts"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.runId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "runId", r.runId, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+			var v RpcStatus
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+					newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+					newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiCoopLogisticsEngineAPIMoveUnitRequest struct {
 	ctx context.Context
 	ApiService *CoopLogisticsEngineAPIAPIService
 	cargoUnitId *string
 	locationLatitude *int64
 	locationLongitude *int64
+	sequence *string
+	eventTime *time.Time
+	idempotencyKey *string
+	runId *string
 }
 
 func (r ApiCoopLogisticsEngineAPIMoveUnitRequest) CargoUnitId(cargoUnitId string) ApiCoopLogisticsEngineAPIMoveUnitRequest {
@@ -45,6 +280,32 @@ func (r ApiCoopLogisticsEngineAPIMoveUnitRequest) LocationLongitude(locationLong
 	return r
 }
 
+// sequence increases by one with every MoveUnitRequest and UnitReachedWarehouseRequest of the cargo unit,
+// starts from 1, zero means sequence is not tracked by sender
+func (r ApiCoopLogisticsEngineAPIMoveUnitRequest) Sequence(sequence string) ApiCoopLogisticsEngineAPIMoveUnitRequest {
+	r.sequence = &sequence
+	return r
+}
+
+// event_time when cargo unit moved
+func (r ApiCoopLogisticsEngineAPIMoveUnitRequest) EventTime(eventTime time.Time) ApiCoopLogisticsEngineAPIMoveUnitRequest {
+	r.eventTime = &eventTime
+	return r
+}
+
+// idempotency_key is the same for every retry of the request, servers may use it to apply request only once
+func (r ApiCoopLogisticsEngineAPIMoveUnitRequest) IdempotencyKey(idempotencyKey string) ApiCoopLogisticsEngineAPIMoveUnitRequest {
+	r.idempotencyKey = &idempotencyKey
+	return r
+}
+
+// run_id identifies client run the request belongs to, sequences and received counts are scoped to run,
+// empty run_id is shared by every sender that does not set it
+func (r ApiCoopLogisticsEngineAPIMoveUnitRequest) RunId(runId string) ApiCoopLogisticsEngineAPIMoveUnitRequest {
+	r.runId = &runId
+	return r
+}
+
 func (r ApiCoopLogisticsEngineAPIMoveUnitRequest) Execute() (map[string]interface{}, *http.Response, error) {
 	return r.ApiService.CoopLogisticsEngineAPIMoveUnitExecute(r)
 }
@@ -92,6 +353,18 @@ func (a *CoopLogisticsEngineAPIAPIService) CoopLogisticsEngineAPIMoveUnitExecute
 	if r.locationLongitude != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "location.Longitude", r.locationLongitude, "")
 	}
+	if r.sequence != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "sequence", r.sequence, "")
+	}
+	if r.eventTime != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "eventTime", r.eventTime, "")
+	}
+	if r.idempotencyKey != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "idempotencyKey", r.idempotencyKey, "")
+	}
+	if r.runId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "runId", r.runId, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -162,6 +435,10 @@ type ApiCoopLogisticsEngineAPIUnitReachedWarehouseRequest struct {
 	announcementCargoUnitId *string
 	announcementWarehouseId *string
 	announcementMessage *string
+	sequence *string
+	eventTime *time.Time
+	idempotencyKey *string
+	runId *string
 }
 
 func (r ApiCoopLogisticsEngineAPIUnitReachedWarehouseRequest) LocationLatitude(locationLatitude int64) ApiCoopLogisticsEngineAPIUnitReachedWarehouseRequest {
@@ -192,6 +469,30 @@ func (r ApiCoopLogisticsEngineAPIUnitReachedWarehouseRequest) AnnouncementMessag
 	return r
 }
 
+// sequence of the cargo unit shared with MoveUnitRequest, see MoveUnitRequest.sequence
+func (r ApiCoopLogisticsEngineAPIUnitReachedWarehouseRequest) Sequence(sequence string) ApiCoopLogisticsEngineAPIUnitReachedWarehouseRequest {
+	r.sequence = &sequence
+	return r
+}
+
+// event_time when cargo unit reached warehouse
+func (r ApiCoopLogisticsEngineAPIUnitReachedWarehouseRequest) EventTime(eventTime time.Time) ApiCoopLogisticsEngineAPIUnitReachedWarehouseRequest {
+	r.eventTime = &eventTime
+	return r
+}
+
+// idempotency_key is the same for every retry of the request, see MoveUnitRequest.idempotency_key
+func (r ApiCoopLogisticsEngineAPIUnitReachedWarehouseRequest) IdempotencyKey(idempotencyKey string) ApiCoopLogisticsEngineAPIUnitReachedWarehouseRequest {
+	r.idempotencyKey = &idempotencyKey
+	return r
+}
+
+// run_id of client run, see MoveUnitRequest.run_id
+func (r ApiCoopLogisticsEngineAPIUnitReachedWarehouseRequest) RunId(runId string) ApiCoopLogisticsEngineAPIUnitReachedWarehouseRequest {
+	r.runId = &runId
+	return r
+}
+
 func (r ApiCoopLogisticsEngineAPIUnitReachedWarehouseRequest) Execute() (map[string]interface{}, *http.Response, error) {
 	return r.ApiService.CoopLogisticsEngineAPIUnitReachedWarehouseExecute(r)
 }
@@ -245,6 +546,18 @@ func (a *CoopLogisticsEngineAPIAPIService) CoopLogisticsEngineAPIUnitReachedWare
 	if r.announcementMessage != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "announcement.message", r.announcementMessage, "")
 	}
+	if r.sequence != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "sequence", r.sequence, "")
+	}
+	if r.eventTime != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "eventTime", r.eventTime, "")
+	}
+	if r.idempotencyKey != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "idempotencyKey", r.idempotencyKey, "")
+	}
+	if r.runId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "runId", r.runId, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -4,75 +4,102 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log"
+	"math"
 	"math/rand"
 	"os"
 	"os/signal"
-	"strconv"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
 	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
 	"github.com/coopnorge/interview-backend/internal/logistics/config"
 	"github.com/coopnorge/interview-backend/internal/logistics/model"
+	"github.com/coopnorge/interview-backend/internal/logistics/report"
 	"github.com/coopnorge/interview-backend/internal/logistics/services/client"
 	"github.com/coopnorge/interview-backend/internal/logistics/services/operator"
+	"github.com/coopnorge/interview-backend/internal/pkg/load"
 	"github.com/coopnorge/interview-backend/internal/pkg/printer"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-const (
-	appName = "Coop Logistics Engine"
+const appName = "Coop Logistics Engine"
 
-	maxWarehouses = 1<<8 - 1
-	maxCargoUnits = 1 << 10
-)
+// errWarehouseNotFound in location where cargo unit stopped
+var errWarehouseNotFound = errors.New("warehouse not found")
+
+// ErrInterrupted returned by Run that was stopped before it finished, statistics and reports of the run are partial
+var ErrInterrupted = errors.New("run interrupted")
 
 // ServiceInstance of application
 type ServiceInstance struct {
 	ctx       context.Context
 	ctxCancel context.CancelFunc
+	// stopCtx is cancelled by Stop, no new requests are scheduled after it
+	stopCtx context.Context
+	stop    context.CancelFunc
+	// requestCtx of sent requests, cancelled if they did not finish within shutdown timeout after Stop
+	requestCtx       context.Context
+	requestCtxCancel context.CancelFunc
+	interrupted      atomic.Bool
 
 	logisticsClient *client.APILogisticsClient
 	worldOperator   *operator.WorldOperator
-
-	maxMoveWaitNumber int
-	reportTable       *printer.ASCIITablePrinter
-	statistics        *model.Statistics
+	random          *rand.Rand
+	cfg             *config.ClientAppConfig
+
+	// logger of run progress and out of printed report, standard logger and STDOUT by default
+	logger *log.Logger
+	out    io.Writer
+	// onRequest observes every sent request, nil if requests are not observed
+	onRequest func(RequestEvent)
+
+	// warehouses and cargoUnits populated in the world, zero when replaying
+	warehouses, cargoUnits uint32
+
+	load         config.ClientLoadConfig
+	profile      *load.Profile
+	loadStart    time.Time
+	loadDuration time.Duration
+	pacer        *movePacer
+	reportTable  *printer.ASCIITablePrinter
+	statistics   *model.Statistics
+
+	// replayRecords sent instead of simulating world if not empty
+	replayRecords []*apiv1.TrafficRecord
+	replaySpeed   float64
 }
 
-// NewServiceInstance constructor
-func NewServiceInstance(lc *client.APILogisticsClient, wo *operator.WorldOperator, cfg *config.ClientAppConfig) (*ServiceInstance, error) {
-	log.Printf("%s, initializing...\n", appName)
-
+// NewServiceInstance constructor, world and pacing are drawn from random seeded with cfg.Seed.
+// It does not connect to API or populate world until Run.
+func NewServiceInstance(lc *client.APILogisticsClient, wo *operator.WorldOperator, random *rand.Rand, cfg *config.ClientAppConfig) (*ServiceInstance, error) {
 	serviceCtx, serviceCtxCancel := context.WithCancel(context.Background())
-	connCtx, connCtxCancel := context.WithTimeout(serviceCtx, 30*time.Second)
-	defer connCtxCancel()
-
-	log.Printf("%s, trying to connect to API - %s...\n", appName, cfg.GetCombinedAddress())
-	if connErr := lc.Connect(cfg.GetCombinedAddress(), connCtx); connErr != nil {
-		serviceCtxCancel()
-		err := errors.New(fmt.Sprintf(
-			"%s, failed to connect to API (%s), error: %v",
-			appName,
-			cfg.GetCombinedAddress(),
-			connErr,
-		))
-
-		return nil, err
-	}
+	stopCtx, stop := context.WithCancel(serviceCtx)
+	requestCtx, requestCtxCancel := context.WithCancel(serviceCtx)
 
 	service := &ServiceInstance{
-		ctx:       serviceCtx,
-		ctxCancel: serviceCtxCancel,
+		ctx:              serviceCtx,
+		ctxCancel:        serviceCtxCancel,
+		stopCtx:          stopCtx,
+		stop:             stop,
+		requestCtx:       requestCtx,
+		requestCtxCancel: requestCtxCancel,
 
 		logisticsClient: lc,
 		worldOperator:   wo,
+		random:          random,
+		cfg:             cfg,
+
+		logger: log.Default(),
+		out:    os.Stdout,
 
-		maxMoveWaitNumber: 100,
-		reportTable:       printer.NewASCIITablePrinter(),
+		load:        cfg.Load,
+		pacer:       newMovePacer(random),
+		reportTable: printer.NewASCIITablePrinter(),
 		statistics: &model.Statistics{
-			ExecTime: time.Now(),
 			Operation: []*model.Operation{
 				{Name: "MoveUnit"},
 				{Name: "UnitReachedWarehouse"},
@@ -80,146 +107,393 @@ func NewServiceInstance(lc *client.APILogisticsClient, wo *operator.WorldOperato
 		},
 	}
 
-	service.reportTable.AddHeader([]string{"Operation", "Count", "Errors"})
-	worldPopulationErr := wo.Populate(
-		uint32(rand.Intn(maxWarehouses-10+1)+10),
-		uint32(rand.Intn(maxCargoUnits-10+1)+10),
-	)
-	if worldPopulationErr != nil {
-		return nil, worldPopulationErr
+	service.reportTable.AddHeader(append([]string{"Operation", "Count", "Errors"}, latencyHeaders()...))
+	if len(cfg.Traffic.ReplayPath) == 0 {
+		if worldErr := cfg.World.Validate(); worldErr != nil {
+			serviceCtxCancel()
+			return nil, fmt.Errorf("%s, %w", appName, worldErr)
+		}
+	}
+	if len(cfg.Load.Profile) > 0 {
+		profile, profileErr := load.ParseProfile(cfg.Load.Profile)
+		if profileErr != nil {
+			serviceCtxCancel()
+			return nil, fmt.Errorf("%s, %w", appName, profileErr)
+		}
+
+		service.profile = profile
+		for i := range profile.Stages {
+			service.statistics.Stages = append(service.statistics.Stages, &model.Operation{Name: profile.Stages[i].String()})
+		}
 	}
 
 	return service, nil
 }
 
-// Run app
-func (s *ServiceInstance) Run() error {
+// SetOutput of run progress logs and printed report
+func (s *ServiceInstance) SetOutput(w io.Writer) {
+	s.logger = log.New(w, "", log.LstdFlags)
+	s.out = w
+}
+
+// Run until every cargo unit reached warehouse, load profile or replay ended or run was stopped, then print
+// and write report of the run. Run that delivered every cargo unit before profile ended fails with
+// ErrProfileCutShort. Done ctx stops run like Stop. Run can be called only once.
+func (s *ServiceInstance) Run(ctx context.Context) (*report.Report, error) {
+	defer s.ctxCancel()
+	stopAfterCtx := context.AfterFunc(ctx, s.Stop)
+	defer stopAfterCtx()
+
+	s.statistics.ExecTime = time.Now()
+	if prepareErr := s.prepare(); prepareErr != nil {
+		_ = s.logisticsClient.Disconnect()
+		return nil, prepareErr
+	}
+
+	loadDone := make(chan struct{})
+	go s.cancelRequestsAfterStop(loadDone)
+
+	s.loadStart = time.Now()
+	gate := newConcurrencyGate(s.load)
+	profileCtx, profileCtxCancel := context.WithCancel(s.stopCtx)
+	if s.profile != nil {
+		value, _ := s.profile.At(0)
+		gate.SetLimit(int(math.Round(value)))
+		go s.followProfile(profileCtx, gate)
+	}
+
+	switch {
+	case len(s.replayRecords) > 0:
+		s.replay()
+	case s.load.IsOpenLoop():
+		s.runOpenLoop()
+	default:
+		s.simulate(gate)
+	}
+	s.loadDuration = time.Since(s.loadStart)
+	profileCtxCancel()
+	close(loadDone)
+	if s.interrupted.Load() {
+		s.logger.Printf("%s, in-flight requests finished, reporting partial run...\n", appName)
+	}
+
+	// Stream must be acknowledged before asking server what it received
+	streamErr := s.logisticsClient.CloseMoveStream()
+
+	s.printReport()
+
+	reconciliationErr := s.reconcile()
+
+	var streamSkipReason, profileSkipReason string
+	if !s.logisticsClient.IsMoveStreamed() {
+		streamSkipReason = "transport does not stream MoveUnit"
+	}
+	profileErr := s.checkProfileCompleted()
+	switch {
+	case s.profile == nil:
+		profileSkipReason = "no load profile"
+	case s.interrupted.Load():
+		profileSkipReason = "run was interrupted"
+		profileErr = nil
+	}
+	sloChecks := s.assertSLOs()
+	checks := append([]report.Check{
+		newCheck("MoveUnits stream acknowledged", streamErr, streamSkipReason),
+		newCheck("Reconciliation", reconciliationErr, ""),
+		s.completionCheck(),
+		newCheck("Load profile completed", profileErr, profileSkipReason),
+	}, sloChecks...)
+	s.printVerdicts(checks)
+	runReport := s.newReport(checks)
+	reportErr := runReport.WriteFiles(s.cfg.Report)
+
+	disconnectErr := s.logisticsClient.Disconnect()
+
+	if streamErr != nil {
+		streamErr = fmt.Errorf("%s, MoveUnits stream failed, error: %w", appName, streamErr)
+	}
+	if errors.Is(reconciliationErr, client.ErrNotSupported) {
+		reconciliationErr = nil
+	}
+	// Interrupted run has partial counts that may not match, it is reported as interrupted with its other errors
+	if s.interrupted.Load() {
+		return runReport, errors.Join(fmt.Errorf("%s, %w", appName, ErrInterrupted), streamErr, reconciliationErr)
+	}
+	if streamErr != nil {
+		return runReport, streamErr
+	}
+	if reconciliationErr != nil {
+		return runReport, reconciliationErr
+	}
+	if profileErr != nil {
+		return runReport, profileErr
+	}
+	if sloErr := sloViolations(sloChecks); sloErr != nil {
+		return runReport, sloErr
+	}
+	if reportErr != nil {
+		return runReport, fmt.Errorf("%s, failed to write report, error: %w", appName, reportErr)
+	}
+	if disconnectErr != nil {
+		return runReport, fmt.Errorf("%s, failed to disconnect from API, error: %w", appName, disconnectErr)
+	}
+
+	return runReport, nil
+}
+
+// Stop run gracefully, no new requests are scheduled and in-flight ones may finish within shutdown timeout
+func (s *ServiceInstance) Stop() {
+	if s.interrupted.Swap(true) {
+		return
+	}
+
+	s.logger.Printf("%s, stopping, waiting up to %s for in-flight requests...\n", appName, s.cfg.ShutdownTimeout)
+	s.stop()
+}
+
+// Abort run, in-flight requests and reconciliation are cancelled right away
+func (s *ServiceInstance) Abort() {
+	s.interrupted.Store(true)
+	s.ctxCancel()
+}
+
+// StopOnSignal of interrupt, first one stops run gracefully and second one aborts it. Returned func stops handling.
+func (s *ServiceInstance) StopOnSignal() func() {
 	signals := make(chan os.Signal, 1)
 	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	done := make(chan struct{})
 
-	go func() { // Handle graceful shutdown
-		<-signals // Wait for the signal
+	go func() {
+		select {
+		case <-done:
+			return
+		case <-signals:
+		}
 
-		log.Printf("%s, shutting down...\n", appName)
+		s.logger.Printf("%s, interrupted, interrupt again to cancel in-flight requests...\n", appName)
+		s.Stop()
 
-		s.ctxCancel()
-		if s.logisticsClient != nil {
-			_ = s.logisticsClient.Disconnect()
+		select {
+		case <-done:
+		case <-signals:
+			s.logger.Printf("%s, interrupted again, cancelling...\n", appName)
+			s.Abort()
 		}
+	}()
+
+	return func() {
+		signal.Stop(signals)
+		close(done)
+	}
+}
 
-		log.Printf("%s, stopped!\n", appName)
+// prepare world, or recorded traffic if it is replayed, and connect to API
+func (s *ServiceInstance) prepare() error {
+	s.logger.Printf("%s, initializing with seed %d, set CLIENT_SEED to reproduce the run...\n", appName, s.cfg.Seed)
 
-		os.Exit(0)
-	}()
+	connCtx, connCtxCancel := context.WithTimeout(s.stopCtx, 30*time.Second)
+	defer connCtxCancel()
 
-	deliveryUnits := s.worldOperator.GetDeliveryUnit()
-	totalDeliveryUnits := len(deliveryUnits)
+	s.logger.Printf("%s, trying to connect to API - %s...\n", appName, s.cfg.GetCombinedAddress())
+	if connErr := s.logisticsClient.Connect(s.cfg.GetCombinedAddress(), connCtx); connErr != nil {
+		return fmt.Errorf("%s, failed to connect to API (%s), error: %w", appName, s.cfg.GetCombinedAddress(), connErr)
+	}
 
-	for {
-		var wg sync.WaitGroup
-		unitsReachedObjective := 0
+	if len(s.cfg.Traffic.ReplayPath) > 0 {
+		return s.loadReplay(s.cfg.Traffic)
+	}
 
-		// Check if all units reached goal
-		for _, unit := range deliveryUnits {
-			if unit.Metadata == true {
-				unitsReachedObjective++
-			}
-		}
+	s.warehouses = randomInRange(s.random, s.cfg.World.MinWarehouses, s.cfg.World.MaxWarehouses)
+	s.cargoUnits = randomInRange(s.random, s.cfg.World.MinCargoUnits, s.cfg.World.MaxCargoUnits)
+	worldPopulationErr := s.worldOperator.Populate(s.warehouses, s.cargoUnits, s.cfg.World.Width, s.cfg.World.Height)
+	if worldPopulationErr != nil {
+		return fmt.Errorf("%s, failed to populate world, error: %w", appName, worldPopulationErr)
+	}
 
-		if unitsReachedObjective == totalDeliveryUnits {
-			log.Println("All delivery units reached warehouse...")
-			break
-		}
+	s.statistics.Units = make(map[int64]*model.UnitStatistics)
+	for _, unit := range s.worldOperator.GetDeliveryUnit() {
+		s.statistics.Units[int64(unit.ID)] = &model.UnitStatistics{}
+	}
 
-		for _, unit := range deliveryUnits {
-			if unit.Metadata == true {
-				continue
-			}
+	return nil
+}
 
-			wg.Add(1)
-			go s.processDelivery(unit, &wg)
+// cancelRequestsAfterStop if they did not finish within shutdown timeout, until load is done
+func (s *ServiceInstance) cancelRequestsAfterStop(loadDone <-chan struct{}) {
+	select {
+	case <-loadDone:
+		return
+	case <-s.stopCtx.Done():
+	}
 
+	select {
+	case <-loadDone:
+	case <-time.After(s.cfg.ShutdownTimeout):
+		s.logger.Printf("%s, shutdown timeout elapsed, cancelling in-flight requests...\n", appName)
+		s.requestCtxCancel()
+	}
+}
+
+// simulate world until every delivery unit reached warehouse or load profile ended. Every unit moves in its own loop
+// and waits only for its own requests, gate limits concurrent requests if profile controls concurrency or
+// in-flight requests are limited.
+func (s *ServiceInstance) simulate(gate *concurrencyGate) {
+	stopGate := context.AfterFunc(s.stopCtx, gate.Close)
+	defer stopGate()
+
+	var wg sync.WaitGroup
+	for _, unit := range s.worldOperator.GetDeliveryUnit() {
+		wg.Add(1)
+		go func(unit *model.GraphNode) {
+			defer wg.Done()
+			s.deliverUnit(unit, gate)
+		}(unit)
+	}
+	wg.Wait()
+
+	switch {
+	case s.stopCtx.Err() != nil:
+		s.logger.Println("Interrupted, stopped scheduling deliveries...")
+	case s.profileEnded():
+		s.logger.Println("Load profile finished...")
+	default:
+		s.logger.Println("All delivery units reached warehouse...")
+	}
+}
+
+// deliverUnit step by step until it reached warehouse, load profile ended or run was stopped
+func (s *ServiceInstance) deliverUnit(unit *model.GraphNode, gate *concurrencyGate) {
+	for !s.worldOperator.IsDelivered(unit.ID) {
+		if s.stopCtx.Err() != nil || s.profileEnded() || !gate.Acquire() {
+			return
 		}
 
-		wg.Wait()
+		s.processDelivery(unit)
+		gate.Release()
 	}
+}
+
+// processDelivery moves unit one step towards nearest warehouse and announces when it reached warehouse
+func (s *ServiceInstance) processDelivery(unit *model.GraphNode) {
+	time.Sleep(s.pacer.Next())
 
-	for _, o := range s.statistics.Operation {
-		s.reportTable.AddRow([]string{
-			o.Name,
-			strconv.FormatUint(o.A, 10),
-			strconv.FormatUint(o.B, 10),
-		})
+	newCoordinate, arrived := s.worldOperator.MoveDeliveryUnitToNearestWarehouse(unit.ID)
+	move := s.newUnitEvent(unit, newCoordinate)
+	var reached unitEvent
+	if arrived {
+		reached = s.newUnitEvent(unit, newCoordinate)
 	}
 
-	fmt.Println("\nExecution time:", time.Since(s.statistics.ExecTime))
-	fmt.Println(s.reportTable)
+	if moveErr := s.sendMoveUnit(unit, move, time.Time{}); moveErr != nil || !arrived {
+		return
+	}
 
-	return nil
+	if reachErr := s.sendUnitReachedWarehouse(unit, reached, time.Time{}); reachErr != nil {
+		return
+	}
+
+	s.worldOperator.MarkDelivered(unit.ID)
 }
 
-func (s *ServiceInstance) processDelivery(unit *model.GraphNode, wg *sync.WaitGroup) {
-	defer wg.Done()
+// unitEvent of cargo unit in coordinate, numbered and timed when it happened in the world rather than when it is sent
+type unitEvent struct {
+	coordinate model.Coordinate
+	sequence   uint64
+	eventTime  time.Time
+}
 
-	time.Sleep(time.Duration(s.maxMoveWaitNumber) * time.Microsecond)
-	s.maxMoveWaitNumber = rand.Intn(s.maxMoveWaitNumber+1) + 1
-	if s.maxMoveWaitNumber >= 1 {
-		s.maxMoveWaitNumber = s.maxMoveWaitNumber >> 1
+// newUnitEvent of unit in coordinate happening now with next sequence of the unit
+func (s *ServiceInstance) newUnitEvent(unit *model.GraphNode, coordinate model.Coordinate) unitEvent {
+	return unitEvent{
+		coordinate: coordinate,
+		sequence:   s.statistics.Units[int64(unit.ID)].NextSequence(),
+		eventTime:  time.Now(),
 	}
+}
 
-	oldCoordinate := *unit.Coordinate
-	newCoordinate := s.worldOperator.MoveDeliveryUnitToNearestWarehouse(unit.ID)
-	unitMessage := fmt.Sprintf("%s moving to - Latitude:%d, Longitude:%d", unit.Name, newCoordinate.X, newCoordinate.Y)
+// sendMoveUnit of unit to coordinate of event and count it in statistics, scheduledAt is zero if request is not scheduled
+func (s *ServiceInstance) sendMoveUnit(unit *model.GraphNode, event unitEvent, scheduledAt time.Time) error {
+	coordinate := event.coordinate
+	unitMessage := fmt.Sprintf("%s moving to - Latitude:%d, Longitude:%d", unit.Name, coordinate.X, coordinate.Y)
 
-	log.Println(unitMessage)
+	s.logger.Println(unitMessage)
+
+	unitStatistics := s.statistics.Units[int64(unit.ID)]
+	stage := s.activeStage()
 
 	s.statistics.Operation[0].AddA()
-	moveErr := s.logisticsClient.MoveUnit(
-		s.ctx,
-		&apiv1.MoveUnitRequest{
-			CargoUnitId: int64(unit.ID),
-			Location: &apiv1.Location{
-				Latitude:  uint32(newCoordinate.X),
-				Longitude: uint32(newCoordinate.Y),
-			},
-		},
-	)
+	unitStatistics.MoveUnit.AddA()
+	req := &apiv1.MoveUnitRequest{
+		CargoUnitId: int64(unit.ID),
+		Location:    newLocation(coordinate),
+		Sequence:    event.sequence,
+		EventTime:   timestamppb.New(event.eventTime),
+	}
+	sentAt := time.Now()
+	moveErr := s.logisticsClient.MoveUnit(s.requestCtx, req)
+	s.statistics.Operation[0].RecordLatency(scheduledAt, sentAt)
+	s.notifyMoveUnit(req, sentAt, moveErr)
+	countInStage(stage, moveErr)
 	if moveErr != nil {
-		log.Printf("filed to send MoveUnit %s, API error: %v\n", unitMessage, moveErr)
+		s.logger.Printf("filed to send MoveUnit %s, API error: %v\n", unitMessage, moveErr)
 		s.statistics.Operation[0].AddB()
-
-		return
-	} else if newCoordinate != oldCoordinate {
-		return
+		unitStatistics.MoveUnit.AddB()
 	}
 
+	return moveErr
+}
+
+// sendUnitReachedWarehouse located in coordinate of event and count it in statistics, scheduledAt is zero if request is not scheduled
+func (s *ServiceInstance) sendUnitReachedWarehouse(unit *model.GraphNode, event unitEvent, scheduledAt time.Time) error {
+	coordinate := event.coordinate
+	unitMessage := fmt.Sprintf("%s moving to - Latitude:%d, Longitude:%d", unit.Name, coordinate.X, coordinate.Y)
 	announcement := fmt.Sprintf("%s - Reached Objective.", unitMessage)
-	warehouse := s.worldOperator.FindEntityByCoordinate(newCoordinate, model.Warehouses)
+	warehouse := s.worldOperator.FindEntityByCoordinate(coordinate, model.Warehouses)
 	if warehouse == nil {
-		log.Printf("Warehouses not found in coordinates Latitude:%d Longitude:%d", newCoordinate.X, newCoordinate.Y)
-		return
+		s.logger.Printf("Warehouses not found in coordinates Latitude:%d Longitude:%d", coordinate.X, coordinate.Y)
+		return errWarehouseNotFound
 	}
 
+	unitStatistics := s.statistics.Units[int64(unit.ID)]
+	stage := s.activeStage()
+
 	s.statistics.Operation[1].AddA()
-	reachErr := s.logisticsClient.UnitReachedWarehouse(
-		s.ctx,
-		&apiv1.UnitReachedWarehouseRequest{
-			Location: &apiv1.Location{Latitude: uint32(newCoordinate.X), Longitude: uint32(newCoordinate.Y)},
-			Announcement: &apiv1.WarehouseAnnouncement{
-				CargoUnitId: int64(unit.ID),
-				WarehouseId: int64(warehouse.ID),
-				Message:     announcement,
-			},
+	unitStatistics.UnitReachedWarehouse.AddA()
+	req := &apiv1.UnitReachedWarehouseRequest{
+		Location: newLocation(coordinate),
+		Announcement: &apiv1.WarehouseAnnouncement{
+			CargoUnitId: int64(unit.ID),
+			WarehouseId: int64(warehouse.ID),
+			Message:     announcement,
 		},
-	)
+		Sequence:  event.sequence,
+		EventTime: timestamppb.New(event.eventTime),
+	}
+	sentAt := time.Now()
+	reachErr := s.logisticsClient.UnitReachedWarehouse(s.requestCtx, req)
+	s.statistics.Operation[1].RecordLatency(scheduledAt, sentAt)
+	s.notifyUnitReachedWarehouse(req, sentAt, reachErr)
+	countInStage(stage, reachErr)
 	if reachErr != nil {
-		log.Printf("filed to send UnitReachedWarehouse %s, API error: %v\n", unitMessage, moveErr)
+		s.logger.Printf("filed to send UnitReachedWarehouse %s, API error: %v\n", unitMessage, reachErr)
 		s.statistics.Operation[1].AddB()
-		return
+		unitStatistics.UnitReachedWarehouse.AddB()
+
+		return reachErr
 	}
 
-	log.Println(announcement)
-	unit.Metadata = true // Unit reached Warehouse
+	unitStatistics.MarkDelivered(time.Now())
+	s.logger.Println(announcement)
+
+	return nil
+}
+
+// randomInRange from minValue to maxValue inclusive
+func randomInRange(random *rand.Rand, minValue, maxValue uint32) uint32 {
+	return minValue + uint32(random.Int63n(int64(maxValue-minValue)+1))
+}
 
-	return
+// newLocation of coordinate, world size is limited by config to uint32 so coordinates always fit
+func newLocation(coordinate model.Coordinate) *apiv1.Location {
+	return &apiv1.Location{Latitude: uint32(coordinate.X), Longitude: uint32(coordinate.Y)}
 }
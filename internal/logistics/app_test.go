@@ -0,0 +1,618 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+	"github.com/coopnorge/interview-backend/internal/logistics/model"
+	"github.com/coopnorge/interview-backend/internal/logistics/report"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/apitest"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/client"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/operator"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/server"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testCargoUnits = 5
+
+// newTestService of small world connected to fake server srv with transport, gRPC goes over in-memory connection
+func newTestService(t testing.TB, transport string, srv apiv1.CoopLogisticsEngineAPIServer, configure func(cfg *config.ClientAppConfig)) *ServiceInstance {
+	t.Helper()
+
+	cfg := &config.ClientAppConfig{}
+	cfg.LoadDefaults()
+	cfg.Seed = 1
+	cfg.TransportTypeProtocol = transport
+	cfg.World = config.ClientWorldConfig{
+		MinWarehouses: 2, MaxWarehouses: 2,
+		MinCargoUnits: testCargoUnits, MaxCargoUnits: testCargoUnits,
+		Width: 16, Height: 16,
+	}
+	cfg.Retry.InitialBackoff = time.Millisecond
+	if configure != nil {
+		configure(cfg)
+	}
+
+	var dialer client.Dialer
+	if transport == client.TransportTypeGRPCStr || transport == client.TransportTypeGRPCStreamStr {
+		dialer = apitest.ServeGRPC(t, srv)
+		cfg.Host, cfg.Port = "bufconn", "0"
+	} else {
+		host, port, splitErr := net.SplitHostPort(apitest.ServeHTTP(t, srv))
+		if splitErr != nil {
+			t.Fatalf("Not expected error from SplitHostPort, error: %v", splitErr)
+		}
+		cfg.Host, cfg.Port = host, port
+	}
+
+	lc, clientErr := client.NewLogisticsClientWithDialer(cfg, dialer)
+	if clientErr != nil {
+		t.Fatalf("Not expected error from NewLogisticsClientWithDialer, error: %v", clientErr)
+	}
+	random := operator.NewRandom(cfg)
+	service, serviceErr := NewServiceInstance(lc, operator.NewWorldOperator(random), random, cfg)
+	if serviceErr != nil {
+		t.Fatalf("Not expected error from NewServiceInstance, error: %v", serviceErr)
+	}
+	service.SetOutput(io.Discard)
+
+	return service
+}
+
+// runTestService until it finishes or test deadline of run passes
+func runTestService(t *testing.T, service *ServiceInstance) (*report.Report, error) {
+	t.Helper()
+
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer ctxCancel()
+
+	return service.Run(ctx)
+}
+
+func TestServiceInstanceRunDeliversEveryUnit(t *testing.T) {
+	transports := []string{
+		client.TransportTypeGRPCStr,
+		client.TransportTypeGRPCStreamStr,
+		client.TransportTypeHTTPStr,
+		client.TransportTypeHTTPJSONStr,
+		client.TransportTypeHTTPProtobufStr,
+	}
+
+	for _, transport := range transports {
+		t.Run(transport, func(t *testing.T) {
+			srv := apitest.NewServer()
+			service := newTestService(t, transport, srv, nil)
+
+			var eventsLock sync.Mutex
+			var events int
+			service.OnRequest(func(RequestEvent) {
+				eventsLock.Lock()
+				defer eventsLock.Unlock()
+				events++
+			})
+
+			runReport, runErr := runTestService(t, service)
+			if runErr != nil {
+				t.Fatalf("Not expected error from Run, error: %v", runErr)
+			}
+			if failed := runReport.Failed(); len(failed) > 0 {
+				t.Errorf("Expected every check to pass, but got %+v", failed)
+			}
+
+			moves, reached := srv.Moves(), srv.Reached()
+			delivered := make(map[int64]bool)
+			for _, req := range reached {
+				delivered[req.GetAnnouncement().GetCargoUnitId()] = true
+			}
+			if len(reached) != testCargoUnits || len(delivered) != testCargoUnits {
+				t.Errorf("Expected %d announcements of different cargo units, but got %d", testCargoUnits, len(reached))
+			}
+
+			lastSequence := make(map[int64]uint64)
+			for _, move := range moves {
+				if move.GetSequence() <= lastSequence[move.GetCargoUnitId()] {
+					t.Errorf("Expected increasing sequence of cargo unit %d, but got %d after %d",
+						move.GetCargoUnitId(), move.GetSequence(), lastSequence[move.GetCargoUnitId()])
+				}
+				lastSequence[move.GetCargoUnitId()] = move.GetSequence()
+			}
+
+			if runReport.Operations[0].Count != uint64(len(moves)) || runReport.Operations[1].Count != uint64(len(reached)) {
+				t.Errorf("Expected counts of %d moves and %d announcements, but got %+v", len(moves), len(reached), runReport.Operations)
+			}
+			if events != len(moves)+len(reached) {
+				t.Errorf("Expected %d observed requests, but got %d", len(moves)+len(reached), events)
+			}
+		})
+	}
+}
+
+func TestServiceInstanceRunCountsErrors(t *testing.T) {
+	srv := apitest.NewServer()
+	srv.SetBehavior(apitest.MethodMoveUnit, apitest.FailEvery(4, status.Error(codes.Unavailable, "down")))
+	srv.SetBehavior(apitest.MethodUnitReachedWarehouse, apitest.FailFirst(1, status.Error(codes.Internal, "not stored")))
+	service := newTestService(t, client.TransportTypeGRPCStr, srv, func(cfg *config.ClientAppConfig) {
+		cfg.Retry.MaxAttempts = 1
+	})
+
+	runReport, runErr := runTestService(t, service)
+	if runErr != nil {
+		t.Fatalf("Not expected error from Run, error: %v", runErr)
+	}
+
+	moveUnit, unitReachedWarehouse := runReport.Operations[0], runReport.Operations[1]
+	if moveUnit.Count != srv.Calls(apitest.MethodMoveUnit) {
+		t.Errorf("Expected %d MoveUnit requests, but got %d", srv.Calls(apitest.MethodMoveUnit), moveUnit.Count)
+	}
+	if expected := moveUnit.Count - uint64(len(srv.Moves())); moveUnit.Errors == 0 || moveUnit.Errors != expected {
+		t.Errorf("Expected %d MoveUnit errors, but got %d", expected, moveUnit.Errors)
+	}
+	if unitReachedWarehouse.Errors != 1 || unitReachedWarehouse.Count != testCargoUnits+1 {
+		t.Errorf("Expected %d UnitReachedWarehouse requests with single error, but got %+v", testCargoUnits+1, unitReachedWarehouse)
+	}
+	if runReport.Retries != 0 {
+		t.Errorf("Expected no retries, but got %d", runReport.Retries)
+	}
+	if failed := runReport.Failed(); len(failed) > 0 {
+		t.Errorf("Expected reconciliation of failed requests to pass, but got %+v", failed)
+	}
+}
+
+func TestServiceInstanceRunCountsRetries(t *testing.T) {
+	srv := apitest.NewServer()
+	srv.SetBehavior(apitest.MethodMoveUnit, apitest.FailFirst(2, status.Error(codes.Unavailable, "down")))
+	service := newTestService(t, client.TransportTypeHTTPStr, srv, nil)
+
+	runReport, runErr := runTestService(t, service)
+	if runErr != nil {
+		t.Fatalf("Not expected error from Run, error: %v", runErr)
+	}
+
+	if runReport.Retries != 2 || runReport.Operations[0].Errors != 0 {
+		t.Errorf("Expected 2 retries without errors, but got %d retries and %d errors", runReport.Retries, runReport.Operations[0].Errors)
+	}
+	if calls := srv.Calls(apitest.MethodMoveUnit); calls != runReport.Operations[0].Count+runReport.Retries {
+		t.Errorf("Expected %d MoveUnit calls, but got %d", runReport.Operations[0].Count+runReport.Retries, calls)
+	}
+}
+
+func TestServiceInstanceRunMeasuresLatency(t *testing.T) {
+	const latency = 5 * time.Millisecond
+
+	srv := apitest.NewServer()
+	srv.SetBehavior(apitest.MethodUnitReachedWarehouse, apitest.Respond(apitest.Response{Latency: latency}))
+	service := newTestService(t, client.TransportTypeGRPCStr, srv, nil)
+
+	runReport, runErr := runTestService(t, service)
+	if runErr != nil {
+		t.Fatalf("Not expected error from Run, error: %v", runErr)
+	}
+
+	if minMs := runReport.Operations[1].Latency.MinMs; minMs < report.Milliseconds(latency) {
+		t.Errorf("Expected UnitReachedWarehouse latency of at least %s, but got %.3fms", latency, minMs)
+	}
+	if minMs := runReport.Operations[0].Latency.MinMs; minMs >= report.Milliseconds(latency) {
+		t.Errorf("Expected MoveUnit latency below %s, but got %.3fms", latency, minMs)
+	}
+}
+
+func TestServiceInstanceRunInterruptedByDroppedCalls(t *testing.T) {
+	srv := apitest.NewServer()
+	srv.SetBehavior(apitest.MethodMoveUnit, apitest.Respond(apitest.Response{Drop: true}))
+	service := newTestService(t, client.TransportTypeGRPCStr, srv, func(cfg *config.ClientAppConfig) {
+		cfg.ShutdownTimeout = 50 * time.Millisecond
+	})
+
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer ctxCancel()
+
+	runReport, runErr := service.Run(ctx)
+	if !errors.Is(runErr, ErrInterrupted) {
+		t.Fatalf("Expected ErrInterrupted, but got %v", runErr)
+	}
+	if !runReport.Interrupted {
+		t.Errorf("Expected report marked as interrupted")
+	}
+	if moveUnit := runReport.Operations[0]; moveUnit.Count == 0 || moveUnit.Errors != moveUnit.Count {
+		t.Errorf("Expected every dropped MoveUnit to fail, but got %+v", moveUnit)
+	}
+	if len(srv.Moves()) != 0 || len(srv.Reached()) != 0 {
+		t.Errorf("Expected no accepted requests, but got %d moves and %d announcements", len(srv.Moves()), len(srv.Reached()))
+	}
+}
+
+// inflatedCountsServer reports one move more for every cargo unit than it received
+type inflatedCountsServer struct {
+	*apitest.Server
+}
+
+func (s inflatedCountsServer) GetReceivedCounts(ctx context.Context, req *apiv1.GetReceivedCountsRequest) (*apiv1.GetReceivedCountsResponse, error) {
+	resp, countsErr := s.Server.GetReceivedCounts(ctx, req)
+	for _, unit := range resp.GetCargoUnits() {
+		unit.MoveUnitCount++
+	}
+
+	return resp, countsErr
+}
+
+func TestServiceInstanceRunTwiceAgainstSameServer(t *testing.T) {
+	srv := server.NewLogisticsServer(store.NewMemoryStore(), server.NewMovementBroker(), server.NewUnitCounter(), server.NewIdempotencyCache())
+
+	// Second run reuses cargo unit IDs and sequences of the first one
+	for run := 1; run <= 2; run++ {
+		runReport, runErr := runTestService(t, newTestService(t, client.TransportTypeGRPCStr, srv, nil))
+		if runErr != nil {
+			t.Fatalf("Not expected error from run %d, error: %v", run, runErr)
+		}
+		if failed := runReport.Failed(); len(failed) > 0 {
+			t.Errorf("Expected every check of run %d to pass, but got %+v", run, failed)
+		}
+	}
+
+	if outOfOrder, duplicates := srv.SequenceAnomalies(); outOfOrder != 0 || duplicates != 0 {
+		t.Errorf("Expected no sequence anomalies across runs, but got %d out of order and %d duplicates", outOfOrder, duplicates)
+	}
+}
+
+func TestServiceInstanceReplayTwiceAgainstSameServer(t *testing.T) {
+	srv := server.NewLogisticsServer(store.NewMemoryStore(), server.NewMovementBroker(), server.NewUnitCounter(), server.NewIdempotencyCache())
+	trafficPath := filepath.Join(t.TempDir(), "traffic.ndjson")
+
+	// Recorded run and both replays are applied and reconciled by the server that took the original traffic
+	runs := []func(cfg *config.ClientAppConfig){
+		func(cfg *config.ClientAppConfig) { cfg.Traffic.RecordPath = trafficPath },
+		func(cfg *config.ClientAppConfig) { cfg.Traffic.ReplayPath = trafficPath },
+		func(cfg *config.ClientAppConfig) { cfg.Traffic.ReplayPath = trafficPath },
+	}
+	for run, configure := range runs {
+		runReport, runErr := runTestService(t, newTestService(t, client.TransportTypeGRPCStr, srv, configure))
+		if runErr != nil {
+			t.Fatalf("Not expected error from run %d, error: %v", run+1, runErr)
+		}
+		if failed := runReport.Failed(); len(failed) > 0 {
+			t.Errorf("Expected every check of run %d to pass, but got %+v", run+1, failed)
+		}
+		if runReport.Operations[0].Count == 0 {
+			t.Errorf("Expected moves sent in run %d", run+1)
+		}
+	}
+}
+
+func TestServiceInstanceRunInterruptedWithMismatch(t *testing.T) {
+	service := newTestService(t, client.TransportTypeGRPCStr, inflatedCountsServer{Server: apitest.NewServer()}, nil)
+	service.OnRequest(func(RequestEvent) { service.Stop() })
+
+	runReport, runErr := runTestService(t, service)
+	if !errors.Is(runErr, ErrInterrupted) || !errors.Is(runErr, ErrReconciliationMismatch) {
+		t.Errorf("Expected ErrInterrupted joined with ErrReconciliationMismatch, but got %v", runErr)
+	}
+	if runReport == nil || !runReport.Interrupted {
+		t.Errorf("Expected report marked as interrupted")
+	}
+}
+
+func TestServiceInstanceRunLimitsInFlightRequests(t *testing.T) {
+	const maxInFlight = 2
+
+	srv := apitest.NewServer()
+	srv.SetBehavior(apitest.MethodMoveUnit, apitest.Respond(apitest.Response{Latency: time.Millisecond}))
+	service := newTestService(t, client.TransportTypeGRPCStr, srv, func(cfg *config.ClientAppConfig) {
+		cfg.Load.MaxInFlight = maxInFlight
+	})
+
+	runReport, runErr := runTestService(t, service)
+	if runErr != nil {
+		t.Fatalf("Not expected error from Run, error: %v", runErr)
+	}
+
+	if got := srv.MaxInFlight(); got == 0 || got > maxInFlight {
+		t.Errorf("Expected at most %d requests in flight, but got %d", maxInFlight, got)
+	}
+	if len(srv.Reached()) != testCargoUnits || len(runReport.Failed()) > 0 {
+		t.Errorf("Expected every cargo unit delivered, but got %d announcements and %+v", len(srv.Reached()), runReport.Failed())
+	}
+}
+
+func TestServiceInstanceRunOpenLoopNumbersMovesWhenComputed(t *testing.T) {
+	srv := apitest.NewServer()
+	// Slow calls let later moves of the same cargo unit overtake earlier ones
+	srv.SetBehavior(apitest.MethodMoveUnit, slowEveryTenth)
+	service := newTestService(t, client.TransportTypeGRPCStr, srv, func(cfg *config.ClientAppConfig) {
+		cfg.Load.Rate = 5000
+	})
+
+	if _, runErr := runTestService(t, service); runErr != nil {
+		t.Fatalf("Not expected error from Run, error: %v", runErr)
+	}
+
+	lastMoves := make(map[int64]*apiv1.MoveUnitRequest)
+	for _, move := range srv.Moves() {
+		if last, ok := lastMoves[move.GetCargoUnitId()]; !ok || move.GetSequence() > last.GetSequence() {
+			lastMoves[move.GetCargoUnitId()] = move
+		}
+	}
+	for _, reached := range srv.Reached() {
+		last := lastMoves[reached.GetAnnouncement().GetCargoUnitId()]
+		if reached.GetSequence() <= last.GetSequence() || reached.GetEventTime().AsTime().Before(last.GetEventTime().AsTime()) {
+			t.Errorf("Expected announcement numbered and timed after last move %v, but got %v", last, reached)
+		}
+		if last.GetLocation().GetLatitude() != reached.GetLocation().GetLatitude() ||
+			last.GetLocation().GetLongitude() != reached.GetLocation().GetLongitude() {
+			t.Errorf("Expected move with highest sequence to reach warehouse in %v, but got %v", reached.GetLocation(), last.GetLocation())
+		}
+	}
+}
+
+func TestServiceInstanceRunProfileCutShort(t *testing.T) {
+	cases := []struct {
+		name     string
+		profile  string
+		cutShort bool
+	}{
+		{name: "world delivered before profile ended", profile: "hold:1000/30s", cutShort: true},
+		{name: "profile ended before world was delivered", profile: "hold:50/200ms"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			service := newTestService(t, client.TransportTypeGRPCStr, apitest.NewServer(), func(cfg *config.ClientAppConfig) {
+				cfg.Load.Profile = c.profile
+			})
+
+			runReport, runErr := runTestService(t, service)
+			if cutShort := errors.Is(runErr, ErrProfileCutShort); cutShort != c.cutShort {
+				t.Errorf("Expected cut short %t, but got error: %v", c.cutShort, runErr)
+			}
+			if !c.cutShort && runErr != nil {
+				t.Errorf("Not expected error from Run, error: %v", runErr)
+			}
+			if failed := runReport.Failed(); c.cutShort != (len(failed) == 1 && failed[0].Name == "Load profile completed") {
+				t.Errorf("Expected failed profile check %t, but got %+v", c.cutShort, failed)
+			}
+		})
+	}
+}
+
+func TestServiceInstanceProcessDelivery(t *testing.T) {
+	srv := apitest.NewServer()
+	service := newTestService(t, client.TransportTypeGRPCStr, srv, nil)
+	if prepareErr := service.prepare(); prepareErr != nil {
+		t.Fatalf("Not expected error from prepare, error: %v", prepareErr)
+	}
+	defer service.logisticsClient.Disconnect()
+
+	unit := service.worldOperator.GetDeliveryUnit()[0]
+	for step := 0; !service.worldOperator.IsDelivered(unit.ID); step++ {
+		if step > 100 {
+			t.Fatalf("Expected cargo unit to reach warehouse within 100 steps")
+		}
+
+		service.processDelivery(unit)
+	}
+
+	unitStatistics := service.statistics.Units[int64(unit.ID)]
+	if moves := uint64(len(srv.Moves())); moves == 0 || unitStatistics.MoveUnit.Total() != moves {
+		t.Errorf("Expected %d MoveUnit requests of cargo unit, but got %d", moves, unitStatistics.MoveUnit.Total())
+	}
+	if reached := srv.Reached(); len(reached) != 1 || reached[0].GetAnnouncement().GetCargoUnitId() != int64(unit.ID) {
+		t.Errorf("Expected single announcement of cargo unit %d, but got %v", unit.ID, reached)
+	}
+	if unitStatistics.UnitReachedWarehouse.Succeeded() != 1 || unitStatistics.DeliveredAt().IsZero() {
+		t.Errorf("Expected cargo unit counted as delivered")
+	}
+}
+
+func TestServiceInstanceReplayStopsWhileInFlightIsFull(t *testing.T) {
+	const records = replayMaxInFlight + 100
+
+	srv := apitest.NewServer()
+	srv.SetBehavior(apitest.MethodMoveUnit, apitest.Respond(apitest.Response{Drop: true}))
+	service := newTestService(t, client.TransportTypeGRPCStr, srv, nil)
+	if prepareErr := service.prepare(); prepareErr != nil {
+		t.Fatalf("Not expected error from prepare, error: %v", prepareErr)
+	}
+	defer service.logisticsClient.Disconnect()
+
+	service.replayRecords = nil
+	service.statistics.Units = make(map[int64]*model.UnitStatistics)
+	for cargoUnitID := int64(1); cargoUnitID <= records; cargoUnitID++ {
+		service.replayRecords = append(service.replayRecords, &apiv1.TrafficRecord{
+			Request: &apiv1.TrafficRecord_MoveUnit{MoveUnit: &apiv1.MoveUnitRequest{CargoUnitId: cargoUnitID}},
+		})
+		service.statistics.Units[cargoUnitID] = &model.UnitStatistics{}
+	}
+
+	// Stop when every replay slot is taken by dropped call, then cancel them like shutdown timeout does
+	go func() {
+		for srv.Calls(apitest.MethodMoveUnit) < replayMaxInFlight {
+			time.Sleep(time.Millisecond)
+		}
+		service.Stop()
+		service.requestCtxCancel()
+	}()
+	service.replay()
+
+	if sent := service.statistics.Operation[0].Total(); sent != replayMaxInFlight {
+		t.Errorf("Expected replay to stop after %d requests in flight, but got %d", replayMaxInFlight, sent)
+	}
+}
+
+// flakyServer fails every 7th call and delays every 5th one, like API under load
+func flakyServer(call uint64) apitest.Response {
+	switch {
+	case call%7 == 0:
+		return apitest.Response{Err: status.Error(codes.Unavailable, "overloaded")}
+	case call%5 == 0:
+		return apitest.Response{Latency: time.Duration(call%3) * time.Millisecond}
+	}
+	return apitest.Response{}
+}
+
+// TestServiceInstanceRunStress runs full loop of large world with many concurrent cargo units,
+// meant to be run with race detector
+func TestServiceInstanceRunStress(t *testing.T) {
+	const cargoUnits = 200
+
+	cases := []struct {
+		name      string
+		transport string
+		load      config.ClientLoadConfig
+	}{
+		{name: "closed loop", transport: client.TransportTypeGRPCStr},
+		{name: "closed loop with max in-flight", transport: client.TransportTypeGRPCStreamStr, load: config.ClientLoadConfig{MaxInFlight: 16}},
+		{name: "open loop", transport: client.TransportTypeGRPCStr, load: config.ClientLoadConfig{Rate: 20000}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			srv := apitest.NewServer()
+			srv.SetBehavior(apitest.MethodMoveUnit, flakyServer)
+			srv.SetBehavior(apitest.MethodUnitReachedWarehouse, flakyServer)
+			service := newTestService(t, c.transport, srv, func(cfg *config.ClientAppConfig) {
+				cfg.World = config.ClientWorldConfig{
+					MinWarehouses: 4, MaxWarehouses: 4,
+					MinCargoUnits: cargoUnits, MaxCargoUnits: cargoUnits,
+					Width: 32, Height: 32,
+				}
+				cfg.Load = c.load
+				cfg.Retry.MaxAttempts = 2
+			})
+
+			var eventsLock sync.Mutex
+			observed := make(map[int64]int)
+			service.OnRequest(func(event RequestEvent) {
+				eventsLock.Lock()
+				defer eventsLock.Unlock()
+				observed[event.CargoUnitID]++
+			})
+
+			runReport, runErr := runTestService(t, service)
+			if runErr != nil {
+				t.Fatalf("Not expected error from Run, error: %v", runErr)
+			}
+			if failed := runReport.Failed(); len(failed) > 0 {
+				t.Errorf("Expected every check to pass, but got %+v", failed)
+			}
+
+			delivered := make(map[int64]bool)
+			for _, req := range srv.Reached() {
+				delivered[req.GetAnnouncement().GetCargoUnitId()] = true
+			}
+			if len(delivered) != cargoUnits || len(observed) != cargoUnits {
+				t.Errorf("Expected %d delivered and observed cargo units, but got %d and %d",
+					cargoUnits, len(delivered), len(observed))
+			}
+			for _, unit := range service.worldOperator.GetDeliveryUnit() {
+				if !service.worldOperator.IsDelivered(unit.ID) {
+					t.Errorf("Expected cargo unit %d marked as delivered", unit.ID)
+				}
+			}
+		})
+	}
+}
+
+// slowEveryTenth call of fake server, like API with latency outliers
+func slowEveryTenth(call uint64) apitest.Response {
+	if call%10 == 0 {
+		return apitest.Response{Latency: 2 * time.Millisecond}
+	}
+	return apitest.Response{}
+}
+
+// latencyEveryCall of fake server with outlier on every tenth call, like API over network
+func latencyEveryCall(call uint64) apitest.Response {
+	if call%10 == 0 {
+		return apitest.Response{Latency: 2 * time.Millisecond}
+	}
+	return apitest.Response{Latency: 100 * time.Microsecond}
+}
+
+// simulateWithBarrier is scheduler client used before every cargo unit moved in its own loop, each tick starts
+// one goroutine per undelivered unit and waits for all of them. It is kept as baseline of the benchmark.
+func (s *ServiceInstance) simulateWithBarrier(_ *concurrencyGate) {
+	units := s.worldOperator.GetDeliveryUnit()
+	for {
+		var wg sync.WaitGroup
+		for _, unit := range units {
+			if s.worldOperator.IsDelivered(unit.ID) {
+				continue
+			}
+
+			wg.Add(1)
+			go func(unit *model.GraphNode) {
+				defer wg.Done()
+				s.processDelivery(unit)
+			}(unit)
+		}
+		wg.Wait()
+
+		delivered := 0
+		for _, unit := range units {
+			if s.worldOperator.IsDelivered(unit.ID) {
+				delivered++
+			}
+		}
+		if delivered == len(units) {
+			return
+		}
+	}
+}
+
+// BenchmarkServiceInstanceSimulate compares tick barrier scheduler with per-unit loops on the same world
+func BenchmarkServiceInstanceSimulate(b *testing.B) {
+	cases := []struct {
+		name        string
+		maxInFlight int
+		simulate    func(s *ServiceInstance, gate *concurrencyGate)
+	}{
+		{name: "barrier", simulate: (*ServiceInstance).simulateWithBarrier},
+		{name: "per-unit", simulate: (*ServiceInstance).simulate},
+		{name: "per-unit-max-in-flight-8", maxInFlight: 8, simulate: (*ServiceInstance).simulate},
+	}
+
+	for _, c := range cases {
+		b.Run(c.name, func(b *testing.B) {
+			b.ReportAllocs()
+
+			var requests uint64
+			var elapsed time.Duration
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				srv := apitest.NewServer()
+				srv.SetBehavior(apitest.MethodMoveUnit, latencyEveryCall)
+				srv.SetBehavior(apitest.MethodUnitReachedWarehouse, latencyEveryCall)
+				service := newTestService(b, client.TransportTypeGRPCStr, srv, func(cfg *config.ClientAppConfig) {
+					cfg.World = config.ClientWorldConfig{MinWarehouses: 2, MaxWarehouses: 2, MinCargoUnits: 50, MaxCargoUnits: 50, Width: 64, Height: 64}
+					cfg.Load.MaxInFlight = c.maxInFlight
+				})
+				if prepareErr := service.prepare(); prepareErr != nil {
+					b.Fatalf("Not expected error from prepare, error: %v", prepareErr)
+				}
+				service.loadStart = time.Now()
+				b.StartTimer()
+
+				c.simulate(service, newConcurrencyGate(service.load))
+
+				b.StopTimer()
+				elapsed += time.Since(service.loadStart)
+				for _, operation := range service.statistics.Operation {
+					requests += operation.Total()
+				}
+				_ = service.logisticsClient.Disconnect()
+				b.StartTimer()
+			}
+
+			b.ReportMetric(float64(requests)/elapsed.Seconds(), "requests/s")
+		})
+	}
+}
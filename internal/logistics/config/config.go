@@ -1,8 +1,12 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 const (
@@ -10,6 +14,62 @@ const (
 	envClientServicePort   = "CLIENT_SERVICE_PORT"
 	envClientTransportType = "CLIENT_TRANSPORT_TYPE"
 	envClientHTTPScheme    = "CLIENT_HTTP_SCHEME"
+	envClientSeed          = "CLIENT_SEED"
+	envClientShutdown      = "CLIENT_SHUTDOWN_TIMEOUT"
+
+	envClientTLSEnabled    = "CLIENT_TLS_ENABLED"
+	envClientTLSCAFile     = "CLIENT_TLS_CA_FILE"
+	envClientTLSCertFile   = "CLIENT_TLS_CERT_FILE"
+	envClientTLSKeyFile    = "CLIENT_TLS_KEY_FILE"
+	envClientTLSServerName = "CLIENT_TLS_SERVER_NAME"
+
+	envClientAuthToken        = "CLIENT_AUTH_TOKEN"
+	envClientAuthTokenFile    = "CLIENT_AUTH_TOKEN_FILE"
+	envClientAuthAPIKey       = "CLIENT_AUTH_API_KEY"
+	envClientAuthAPIKeyHeader = "CLIENT_AUTH_API_KEY_HEADER"
+
+	envClientWorldWarehouses = "CLIENT_WORLD_WAREHOUSES"
+	envClientWorldCargoUnits = "CLIENT_WORLD_CARGO_UNITS"
+	envClientWorldWidth      = "CLIENT_WORLD_WIDTH"
+	envClientWorldHeight     = "CLIENT_WORLD_HEIGHT"
+
+	envClientLoadRate          = "CLIENT_LOAD_RATE"
+	envClientLoadProfile       = "CLIENT_LOAD_PROFILE"
+	envClientLoadProfileTarget = "CLIENT_LOAD_PROFILE_TARGET"
+	envClientLoadMaxInFlight   = "CLIENT_LOAD_MAX_IN_FLIGHT"
+
+	// LoadTargetRate of load profile controls requests per second in open loop.
+	LoadTargetRate = "rate"
+	// LoadTargetConcurrency of load profile controls concurrent requests in closed loop.
+	LoadTargetConcurrency = "concurrency"
+
+	envClientReportJSON  = "CLIENT_REPORT_JSON"
+	envClientReportCSV   = "CLIENT_REPORT_CSV"
+	envClientReportJUnit = "CLIENT_REPORT_JUNIT"
+
+	envClientSLOMaxErrorRate    = "CLIENT_SLO_MAX_ERROR_RATE"
+	envClientSLOMaxP99          = "CLIENT_SLO_MAX_P99"
+	envClientSLOMinThroughput   = "CLIENT_SLO_MIN_THROUGHPUT"
+	envClientSLODeliveredWithin = "CLIENT_SLO_DELIVERED_WITHIN"
+
+	envClientRecordPath  = "CLIENT_RECORD_PATH"
+	envClientReplayPath  = "CLIENT_REPLAY_PATH"
+	envClientReplaySpeed = "CLIENT_REPLAY_SPEED"
+
+	// DefaultAPIKeyHeader where API key is sent if header is not configured.
+	DefaultAPIKeyHeader = "X-API-Key"
+
+	// MaxWorldActors of each kind, warehouses or cargo units, in simulated world.
+	MaxWorldActors = 1 << 20
+	// MaxWorldSide is the largest width or height of map.
+	MaxWorldSide = 1 << 16
+
+	envClientRetryMaxAttempts    = "CLIENT_RETRY_MAX_ATTEMPTS"
+	envClientRetryInitialBackoff = "CLIENT_RETRY_INITIAL_BACKOFF"
+	envClientRetryMaxBackoff     = "CLIENT_RETRY_MAX_BACKOFF"
+	envClientRetryJitter         = "CLIENT_RETRY_JITTER"
+	envClientRetryGRPCCodes      = "CLIENT_RETRY_GRPC_CODES"
+	envClientRetryHTTPStatuses   = "CLIENT_RETRY_HTTP_STATUSES"
 )
 
 // ClientAppConfig ...
@@ -17,8 +77,126 @@ type ClientAppConfig struct {
 	Host   string
 	Port   string
 	Scheme string
-	// TransportTypeProtocol gRPC or HTTP.
+	// TransportTypeProtocol gRPC, gRPCStream or HTTP.
 	TransportTypeProtocol string
+	// Seed of random source that generates world and its movements, the same seed reproduces the run.
+	Seed int64
+	// ShutdownTimeout how long in-flight requests may finish after interrupt before they are cancelled.
+	ShutdownTimeout time.Duration
+
+	World ClientWorldConfig
+	Load  ClientLoadConfig
+
+	Retry ClientRetryConfig
+	TLS   ClientTLSConfig
+	Auth  ClientAuthConfig
+
+	Traffic ClientTrafficConfig
+	Report  ClientReportConfig
+	SLO     ClientSLOConfig
+}
+
+// ClientSLOConfig of assertions evaluated at the end of run, run fails if any of them is violated
+type ClientSLOConfig struct {
+	// MaxErrorRate fraction of failed requests of every operation like 0.01, negative is not asserted.
+	MaxErrorRate float64
+	// MaxP99 latency of every operation, corrected latency in open loop, 0 is not asserted.
+	MaxP99 time.Duration
+	// MinThroughput of successful requests per second, 0 is not asserted.
+	MinThroughput float64
+	// DeliveredWithin time every cargo unit must reach warehouse, 0 is not asserted.
+	DeliveredWithin time.Duration
+}
+
+// ClientReportConfig of machine-readable run reports, format is not written if its path is empty
+type ClientReportConfig struct {
+	JSONPath  string
+	CSVPath   string
+	JUnitPath string
+}
+
+// ClientWorldConfig of simulated world, counts are picked randomly from their ranges and are fixed if min equals max
+type ClientWorldConfig struct {
+	MinWarehouses, MaxWarehouses uint32
+	MinCargoUnits, MaxCargoUnits uint32
+	// Width and Height of map, locations are from 0 to Width-1 and Height-1.
+	Width, Height uint32
+
+	// loadErr of environment variables that could not be parsed, returned by Validate
+	loadErr error
+}
+
+// ClientLoadConfig of how requests are scheduled
+type ClientLoadConfig struct {
+	// Rate of requests per second sent in open loop regardless of response time,
+	// 0 is closed loop where every unit waits for its previous request.
+	Rate float64
+	// Profile of stages like ramp:0-500/30s,hold:500/1m that controls ProfileTarget over time,
+	// used instead of Rate and run ends with the profile.
+	Profile string
+	// ProfileTarget is LoadTargetRate or LoadTargetConcurrency.
+	ProfileTarget string
+	// MaxInFlight requests at any time, 0 is one per cargo unit in closed loop and 4096 in open loop.
+	MaxInFlight int
+}
+
+// IsOpenLoop if requests are scheduled at fixed rate or rate controlled by profile
+func (cfg *ClientLoadConfig) IsOpenLoop() bool {
+	if len(cfg.Profile) > 0 {
+		return cfg.ProfileTarget == LoadTargetRate
+	}
+
+	return cfg.Rate > 0
+}
+
+// ClientTrafficConfig of recording and replaying client traffic,
+// files with .ndjson, .jsonl or .json extension are NDJSON, other are length-delimited protobuf.
+type ClientTrafficConfig struct {
+	// RecordPath where every sent request is recorded, not recorded if empty.
+	RecordPath string
+	// ReplayPath of recorded traffic that is sent instead of simulating new world.
+	ReplayPath string
+	// ReplaySpeed multiplier of recorded pace, 1 is original speed and 0 is as fast as possible.
+	ReplaySpeed float64
+}
+
+// ClientAuthConfig of credentials attached to every request, anonymous if empty
+type ClientAuthConfig struct {
+	// Token sent as bearer token in Authorization header.
+	Token string
+	// TokenFile with bearer token, used instead of Token and re-read when it changes.
+	TokenFile string
+	// APIKey sent in APIKeyHeader.
+	APIKey       string
+	APIKeyHeader string
+}
+
+// ClientTLSConfig for gRPC and HTTP transports
+type ClientTLSConfig struct {
+	// Enabled TLS with system root CAs, implied by any of files below.
+	Enabled bool
+	// CAFile PEM bundle used instead of system root CAs to verify server.
+	CAFile string
+	// CertFile and KeyFile PEM pair presented to server that requires mutual TLS.
+	CertFile string
+	KeyFile  string
+	// ServerName overrides host name used to verify server certificate.
+	ServerName string
+}
+
+// ClientRetryConfig of requests that failed with transient error
+type ClientRetryConfig struct {
+	// MaxAttempts including first one, 1 disables retries.
+	MaxAttempts int
+	// InitialBackoff before second attempt, doubled for each next one up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Jitter fraction of backoff that is randomized, from 0 to 1.
+	Jitter float64
+	// GRPCCodes names like Unavailable that are retried.
+	GRPCCodes []string
+	// HTTPStatuses codes like 503 that are retried.
+	HTTPStatuses []string
 }
 
 // GetCombinedAddress with Host and Port
@@ -28,25 +206,377 @@ func (cfg *ClientAppConfig) GetCombinedAddress() string {
 
 // LoadFromEnv form environment variables
 func (cfg *ClientAppConfig) LoadFromEnv() {
-	cfg.Host = os.Getenv(envClientServiceHost)
+	cfg.LoadFrom(os.Getenv)
+}
+
+// LoadDefaults of every value, the same as LoadFromEnv with empty environment
+func (cfg *ClientAppConfig) LoadDefaults() {
+	cfg.LoadFrom(func(string) string { return "" })
+}
+
+// LoadFrom getenv that returns value of environment variable by its name
+func (cfg *ClientAppConfig) LoadFrom(getenv func(string) string) {
+	cfg.Host = getenv(envClientServiceHost)
 	if len(cfg.Host) == 0 {
 		cfg.Host = "0.0.0.0"
 	}
-	cfg.Port = os.Getenv(envClientServicePort)
+	cfg.Port = getenv(envClientServicePort)
 	if len(cfg.Port) == 0 {
 		cfg.Port = "50051"
 	}
 
-	cfg.TransportTypeProtocol = os.Getenv(envClientTransportType)
-	cfg.Scheme = os.Getenv(envClientHTTPScheme)
+	cfg.TransportTypeProtocol = getenv(envClientTransportType)
+	cfg.Scheme = getenv(envClientHTTPScheme)
+
+	var seedErr error
+	if cfg.Seed, seedErr = strconv.ParseInt(getenv(envClientSeed), 10, 64); seedErr != nil {
+		cfg.Seed = time.Now().UnixNano()
+	}
+	var shutdownErr error
+	if cfg.ShutdownTimeout, shutdownErr = time.ParseDuration(getenv(envClientShutdown)); shutdownErr != nil || cfg.ShutdownTimeout < 0 {
+		cfg.ShutdownTimeout = 10 * time.Second
+	}
+
+	cfg.World.LoadFrom(getenv)
+	cfg.Load.LoadFrom(getenv)
+	cfg.Retry.LoadFrom(getenv)
+	cfg.TLS.LoadFrom(getenv)
+	cfg.Auth.LoadFrom(getenv)
+	cfg.Traffic.LoadFrom(getenv)
+	cfg.Report.LoadFrom(getenv)
+	cfg.SLO.LoadFrom(getenv)
+}
+
+// LoadFrom environment variables, counts are either number like 100 or range like 10-255,
+// defaults are used if variables are empty and invalid values are reported by Validate
+func (cfg *ClientWorldConfig) LoadFrom(getenv func(string) string) {
+	var warehousesErr, cargoUnitsErr, widthErr, heightErr error
+	cfg.MinWarehouses, cfg.MaxWarehouses, warehousesErr = parseRange(envClientWorldWarehouses, getenv(envClientWorldWarehouses), 10, 255)
+	cfg.MinCargoUnits, cfg.MaxCargoUnits, cargoUnitsErr = parseRange(envClientWorldCargoUnits, getenv(envClientWorldCargoUnits), 10, 1024)
+	cfg.Width, widthErr = parseSize(envClientWorldWidth, getenv(envClientWorldWidth), 255)
+	cfg.Height, heightErr = parseSize(envClientWorldHeight, getenv(envClientWorldHeight), 255)
+	cfg.loadErr = errors.Join(warehousesErr, cargoUnitsErr, widthErr, heightErr)
+}
+
+// Validate that counts are ranges of at most MaxWorldActors, sides of map are from 1 to MaxWorldSide and
+// warehouses and cargo units fit on the map
+func (cfg *ClientWorldConfig) Validate() error {
+	if cfg.loadErr != nil {
+		return cfg.loadErr
+	}
+
+	var validateErrs []error
+	for _, side := range []struct {
+		name  string
+		value uint32
+	}{
+		{name: envClientWorldWidth, value: cfg.Width},
+		{name: envClientWorldHeight, value: cfg.Height},
+	} {
+		if side.value == 0 || side.value > MaxWorldSide {
+			validateErrs = append(validateErrs, fmt.Errorf("%s %d must be from 1 to %d", side.name, side.value, MaxWorldSide))
+		}
+	}
+
+	// every warehouse and every cargo unit is placed on its own location
+	cells := uint64(cfg.Width) * uint64(cfg.Height)
+	for _, count := range []struct {
+		name     string
+		min, max uint32
+	}{
+		{name: envClientWorldWarehouses, min: cfg.MinWarehouses, max: cfg.MaxWarehouses},
+		{name: envClientWorldCargoUnits, min: cfg.MinCargoUnits, max: cfg.MaxCargoUnits},
+	} {
+		switch {
+		case count.min == 0:
+			validateErrs = append(validateErrs, fmt.Errorf("%s %d-%d must start from 1", count.name, count.min, count.max))
+		case count.min > count.max:
+			validateErrs = append(validateErrs, fmt.Errorf("%s %d-%d has min greater than max", count.name, count.min, count.max))
+		case count.max > MaxWorldActors:
+			validateErrs = append(validateErrs, fmt.Errorf("%s %d must not be greater than %d", count.name, count.max, MaxWorldActors))
+		case uint64(count.max) > cells:
+			validateErrs = append(validateErrs, fmt.Errorf(
+				"%s %d does not fit on %dx%d map of %s and %s",
+				count.name,
+				count.max,
+				cfg.Width,
+				cfg.Height,
+				envClientWorldWidth,
+				envClientWorldHeight,
+			))
+		}
+	}
+
+	return errors.Join(validateErrs...)
+}
+
+// LoadFrom environment variables, invalid rate falls back to closed loop
+// and unknown profile target to LoadTargetRate
+func (cfg *ClientLoadConfig) LoadFrom(getenv func(string) string) {
+	cfg.Rate = 0
+	if v, err := strconv.ParseFloat(getenv(envClientLoadRate), 64); err == nil && v > 0 {
+		cfg.Rate = v
+	}
+
+	cfg.Profile = getenv(envClientLoadProfile)
+	cfg.ProfileTarget = getenv(envClientLoadProfileTarget)
+	if cfg.ProfileTarget != LoadTargetConcurrency {
+		cfg.ProfileTarget = LoadTargetRate
+	}
+
+	cfg.MaxInFlight = 0
+	if v, err := strconv.Atoi(getenv(envClientLoadMaxInFlight)); err == nil && v > 0 {
+		cfg.MaxInFlight = v
+	}
+}
+
+// LoadFrom environment variables, invalid values fall back to defaults
+func (cfg *ClientRetryConfig) LoadFrom(getenv func(string) string) {
+	cfg.MaxAttempts = 3
+	if v, err := strconv.Atoi(getenv(envClientRetryMaxAttempts)); err == nil && v > 0 {
+		cfg.MaxAttempts = v
+	}
+	cfg.InitialBackoff = 50 * time.Millisecond
+	if v, err := time.ParseDuration(getenv(envClientRetryInitialBackoff)); err == nil && v >= 0 {
+		cfg.InitialBackoff = v
+	}
+	cfg.MaxBackoff = time.Second
+	if v, err := time.ParseDuration(getenv(envClientRetryMaxBackoff)); err == nil && v >= 0 {
+		cfg.MaxBackoff = v
+	}
+	cfg.Jitter = 0.2
+	if v, err := strconv.ParseFloat(getenv(envClientRetryJitter), 64); err == nil && v >= 0 && v <= 1 {
+		cfg.Jitter = v
+	}
+
+	cfg.GRPCCodes = splitList(getenv(envClientRetryGRPCCodes))
+	if len(cfg.GRPCCodes) == 0 {
+		cfg.GRPCCodes = []string{"Unavailable", "ResourceExhausted", "Aborted"}
+	}
+	cfg.HTTPStatuses = splitList(getenv(envClientRetryHTTPStatuses))
+	if len(cfg.HTTPStatuses) == 0 {
+		cfg.HTTPStatuses = []string{"429", "502", "503", "504"}
+	}
+}
+
+// LoadFrom environment variables
+func (cfg *ClientTLSConfig) LoadFrom(getenv func(string) string) {
+	cfg.Enabled, _ = strconv.ParseBool(getenv(envClientTLSEnabled))
+	cfg.CAFile = getenv(envClientTLSCAFile)
+	cfg.CertFile = getenv(envClientTLSCertFile)
+	cfg.KeyFile = getenv(envClientTLSKeyFile)
+	cfg.ServerName = getenv(envClientTLSServerName)
+}
+
+// IsEnabled if TLS is explicitly enabled or any of TLS options is set
+func (cfg *ClientTLSConfig) IsEnabled() bool {
+	return cfg.Enabled || len(cfg.CAFile) > 0 || len(cfg.CertFile) > 0 || len(cfg.KeyFile) > 0 || len(cfg.ServerName) > 0
+}
+
+// LoadFrom environment variables
+func (cfg *ClientAuthConfig) LoadFrom(getenv func(string) string) {
+	cfg.Token = getenv(envClientAuthToken)
+	cfg.TokenFile = getenv(envClientAuthTokenFile)
+	cfg.APIKey = getenv(envClientAuthAPIKey)
+	cfg.APIKeyHeader = getenv(envClientAuthAPIKeyHeader)
+	if len(cfg.APIKeyHeader) == 0 {
+		cfg.APIKeyHeader = DefaultAPIKeyHeader
+	}
+}
+
+// LoadFrom environment variables, invalid replay speed falls back to original speed
+func (cfg *ClientTrafficConfig) LoadFrom(getenv func(string) string) {
+	cfg.RecordPath = getenv(envClientRecordPath)
+	cfg.ReplayPath = getenv(envClientReplayPath)
+	cfg.ReplaySpeed = 1
+	if v, err := strconv.ParseFloat(getenv(envClientReplaySpeed), 64); err == nil && v >= 0 {
+		cfg.ReplaySpeed = v
+	}
+}
+
+// LoadFrom environment variables
+func (cfg *ClientReportConfig) LoadFrom(getenv func(string) string) {
+	cfg.JSONPath = getenv(envClientReportJSON)
+	cfg.CSVPath = getenv(envClientReportCSV)
+	cfg.JUnitPath = getenv(envClientReportJUnit)
+}
+
+// LoadFrom environment variables, invalid values are not asserted
+func (cfg *ClientSLOConfig) LoadFrom(getenv func(string) string) {
+	cfg.MaxErrorRate = -1
+	if v, err := strconv.ParseFloat(getenv(envClientSLOMaxErrorRate), 64); err == nil && v >= 0 {
+		cfg.MaxErrorRate = v
+	}
+	cfg.MaxP99, _ = time.ParseDuration(getenv(envClientSLOMaxP99))
+	cfg.MinThroughput, _ = strconv.ParseFloat(getenv(envClientSLOMinThroughput), 64)
+	cfg.DeliveredWithin, _ = time.ParseDuration(getenv(envClientSLODeliveredWithin))
 }
 
 // String impl
 func (cfg *ClientAppConfig) String() string {
 	return fmt.Sprintf(
-		"---Client Configuration---\nProtocol:%s\nHost:%s\nPort:%s\n",
+		"---Client Configuration---\nProtocol:%s\nHost:%s\nPort:%s\nSeed:%d\nShutdown Timeout:%s\n%s%s%s%s%s%s%s%s",
 		cfg.TransportTypeProtocol,
 		cfg.Host,
 		cfg.Port,
+		cfg.Seed,
+		cfg.ShutdownTimeout,
+		&cfg.World,
+		&cfg.Load,
+		&cfg.Retry,
+		&cfg.TLS,
+		&cfg.Auth,
+		&cfg.Traffic,
+		&cfg.Report,
+		&cfg.SLO,
+	)
+}
+
+// String impl
+func (cfg *ClientWorldConfig) String() string {
+	return fmt.Sprintf(
+		"World Warehouses:%d-%d\nWorld Cargo Units:%d-%d\nWorld Size:%dx%d\n",
+		cfg.MinWarehouses,
+		cfg.MaxWarehouses,
+		cfg.MinCargoUnits,
+		cfg.MaxCargoUnits,
+		cfg.Width,
+		cfg.Height,
+	)
+}
+
+// String impl
+func (cfg *ClientLoadConfig) String() string {
+	var load string
+	switch {
+	case len(cfg.Profile) > 0:
+		load = fmt.Sprintf("Load:%s profile %s\n", cfg.ProfileTarget, cfg.Profile)
+	case !cfg.IsOpenLoop():
+		load = "Load:closed loop\n"
+	default:
+		load = fmt.Sprintf("Load:open loop at %g requests/s\n", cfg.Rate)
+	}
+
+	return fmt.Sprintf("%sLoad Max In-Flight:%d\n", load, cfg.MaxInFlight)
+}
+
+// String impl
+func (cfg *ClientRetryConfig) String() string {
+	return fmt.Sprintf(
+		"Retry Max Attempts:%d\nRetry Backoff:%s-%s\nRetry Jitter:%.2f\nRetry gRPC Codes:%s\nRetry HTTP Statuses:%s\n",
+		cfg.MaxAttempts,
+		cfg.InitialBackoff,
+		cfg.MaxBackoff,
+		cfg.Jitter,
+		strings.Join(cfg.GRPCCodes, ","),
+		strings.Join(cfg.HTTPStatuses, ","),
 	)
 }
+
+// String impl
+func (cfg *ClientTLSConfig) String() string {
+	return fmt.Sprintf(
+		"TLS:%t\nTLS CA File:%s\nTLS Cert File:%s\nTLS Server Name:%s\n",
+		cfg.IsEnabled(),
+		cfg.CAFile,
+		cfg.CertFile,
+		cfg.ServerName,
+	)
+}
+
+// String impl, without secrets
+func (cfg *ClientAuthConfig) String() string {
+	var methods []string
+	switch {
+	case len(cfg.TokenFile) > 0:
+		methods = append(methods, fmt.Sprintf("bearer token from %s", cfg.TokenFile))
+	case len(cfg.Token) > 0:
+		methods = append(methods, "bearer token")
+	}
+	if len(cfg.APIKey) > 0 {
+		methods = append(methods, fmt.Sprintf("API key in %s", cfg.APIKeyHeader))
+	}
+	if len(methods) == 0 {
+		methods = append(methods, "anonymous")
+	}
+
+	return fmt.Sprintf("Auth:%s\n", strings.Join(methods, ", "))
+}
+
+// String impl
+func (cfg *ClientTrafficConfig) String() string {
+	return fmt.Sprintf(
+		"Record Path:%s\nReplay Path:%s\nReplay Speed:%g\n",
+		cfg.RecordPath,
+		cfg.ReplayPath,
+		cfg.ReplaySpeed,
+	)
+}
+
+// parseRange like 10-255 or single number like 100 for fixed range,
+// defaults are returned if value is empty, invalid, zero or min is above max
+func parseRange(name, value string, defaultMin, defaultMax uint32) (uint32, uint32, error) {
+	if len(strings.TrimSpace(value)) == 0 {
+		return defaultMin, defaultMax, nil
+	}
+
+	minValue, maxValue, isRange := strings.Cut(value, "-")
+	if !isRange {
+		maxValue = minValue
+	}
+
+	parsedMin, minErr := strconv.ParseUint(strings.TrimSpace(minValue), 10, 32)
+	parsedMax, maxErr := strconv.ParseUint(strings.TrimSpace(maxValue), 10, 32)
+	if minErr != nil || maxErr != nil {
+		return defaultMin, defaultMax, fmt.Errorf("%s %q must be number like 100 or range like 10-255", name, value)
+	}
+
+	return uint32(parsedMin), uint32(parsedMax), nil
+}
+
+// parseSize of map side, default if value is empty
+func parseSize(name, value string, defaultSize uint32) (uint32, error) {
+	if len(strings.TrimSpace(value)) == 0 {
+		return defaultSize, nil
+	}
+
+	parsed, parseErr := strconv.ParseUint(strings.TrimSpace(value), 10, 32)
+	if parseErr != nil {
+		return defaultSize, fmt.Errorf("%s %q must be number like 255", name, value)
+	}
+
+	return uint32(parsed), nil
+}
+
+// String impl
+func (cfg *ClientReportConfig) String() string {
+	return fmt.Sprintf(
+		"Report JSON:%s\nReport CSV:%s\nReport JUnit:%s\n",
+		cfg.JSONPath,
+		cfg.CSVPath,
+		cfg.JUnitPath,
+	)
+}
+
+// String impl
+func (cfg *ClientSLOConfig) String() string {
+	return fmt.Sprintf(
+		"SLO Max Error Rate:%g\nSLO Max p99:%s\nSLO Min Throughput:%g\nSLO Delivered Within:%s\n",
+		cfg.MaxErrorRate,
+		cfg.MaxP99,
+		cfg.MinThroughput,
+		cfg.DeliveredWithin,
+	)
+}
+
+// splitList of comma separated values without empty ones
+func splitList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); len(item) > 0 {
+			list = append(list, item)
+		}
+	}
+
+	return list
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestClientWorldConfigDefaults(t *testing.T) {
+	cfg := ClientWorldConfig{}
+	cfg.LoadFrom(func(string) string { return "" })
+
+	if validateErr := cfg.Validate(); validateErr != nil {
+		t.Errorf("Not expected error from Validate, error: %v", validateErr)
+	}
+	if cfg.MinWarehouses != 10 || cfg.MaxWarehouses != 255 || cfg.Width != 255 || cfg.Height != 255 {
+		t.Errorf("Expected default world, but got %+v", cfg)
+	}
+}
+
+func TestClientWorldConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name string
+		env  map[string]string
+		// wantErr names variable in error, empty if config is valid
+		wantErr string
+	}{
+		{name: "fixed counts", env: map[string]string{envClientWorldWarehouses: "5", envClientWorldCargoUnits: "20-30"}},
+		{name: "full map", env: map[string]string{envClientWorldCargoUnits: "16", envClientWorldWarehouses: "16", envClientWorldWidth: "4", envClientWorldHeight: "4"}},
+		{name: "not number", env: map[string]string{envClientWorldWarehouses: "many"}, wantErr: envClientWorldWarehouses},
+		{name: "min greater than max", env: map[string]string{envClientWorldCargoUnits: "300-200"}, wantErr: envClientWorldCargoUnits},
+		{name: "zero count", env: map[string]string{envClientWorldWarehouses: "0"}, wantErr: envClientWorldWarehouses},
+		{name: "too many actors", env: map[string]string{envClientWorldCargoUnits: "4294967295", envClientWorldWidth: "65536", envClientWorldHeight: "65536"}, wantErr: envClientWorldCargoUnits},
+		{name: "zero width", env: map[string]string{envClientWorldWidth: "0"}, wantErr: envClientWorldWidth},
+		{name: "too high", env: map[string]string{envClientWorldHeight: "100000"}, wantErr: envClientWorldHeight},
+		{name: "not number size", env: map[string]string{envClientWorldWidth: "wide"}, wantErr: envClientWorldWidth},
+		{name: "more cargo units than cells", env: map[string]string{envClientWorldCargoUnits: "17", envClientWorldWarehouses: "2", envClientWorldWidth: "4", envClientWorldHeight: "4"}, wantErr: envClientWorldCargoUnits},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := ClientWorldConfig{}
+			cfg.LoadFrom(func(name string) string { return tc.env[name] })
+
+			validateErr := cfg.Validate()
+			if len(tc.wantErr) == 0 {
+				if validateErr != nil {
+					t.Errorf("Not expected error from Validate, error: %v", validateErr)
+				}
+				return
+			}
+			if validateErr == nil || !strings.Contains(validateErr.Error(), tc.wantErr) {
+				t.Errorf("Expected error naming %s, but got %v", tc.wantErr, validateErr)
+			}
+		})
+	}
+}
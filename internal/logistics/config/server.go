@@ -0,0 +1,81 @@
+package config
+
+import (
+	"fmt"
+	"os"
+)
+
+const (
+	envServerHost      = "SERVER_HOST"
+	envServerGRPCPort  = "SERVER_GRPC_PORT"
+	envServerHTTPPort  = "SERVER_HTTP_PORT"
+	envServerStorePath = "SERVER_STORE_PATH"
+
+	envServerAuthToken        = "SERVER_AUTH_TOKEN"
+	envServerAuthAPIKey       = "SERVER_AUTH_API_KEY"
+	envServerAuthAPIKeyHeader = "SERVER_AUTH_API_KEY_HEADER"
+)
+
+// ServerAppConfig of reference API server
+type ServerAppConfig struct {
+	Host string
+	// GRPCPort where CoopLogisticsEngineAPI served over gRPC.
+	GRPCPort string
+	// HTTPPort where grpc-gateway serves HTTP mapping of CoopLogisticsEngineAPI.
+	HTTPPort string
+	// StorePath of append-only delivery path log, delivery paths are kept only in memory if empty.
+	StorePath string
+
+	// AuthToken accepted as bearer token, requests are authenticated only if AuthToken or AuthAPIKey is set.
+	AuthToken string
+	// AuthAPIKey accepted in AuthAPIKeyHeader.
+	AuthAPIKey       string
+	AuthAPIKeyHeader string
+}
+
+// GetGRPCAddress with Host and GRPCPort
+func (cfg *ServerAppConfig) GetGRPCAddress() string {
+	return fmt.Sprintf("%s:%s", cfg.Host, cfg.GRPCPort)
+}
+
+// GetHTTPAddress with Host and HTTPPort
+func (cfg *ServerAppConfig) GetHTTPAddress() string {
+	return fmt.Sprintf("%s:%s", cfg.Host, cfg.HTTPPort)
+}
+
+// LoadFromEnv form environment variables
+func (cfg *ServerAppConfig) LoadFromEnv() {
+	cfg.Host = os.Getenv(envServerHost)
+	if len(cfg.Host) == 0 {
+		cfg.Host = "0.0.0.0"
+	}
+	cfg.GRPCPort = os.Getenv(envServerGRPCPort)
+	if len(cfg.GRPCPort) == 0 {
+		cfg.GRPCPort = "50051"
+	}
+	cfg.HTTPPort = os.Getenv(envServerHTTPPort)
+	if len(cfg.HTTPPort) == 0 {
+		cfg.HTTPPort = "8080"
+	}
+
+	cfg.StorePath = os.Getenv(envServerStorePath)
+
+	cfg.AuthToken = os.Getenv(envServerAuthToken)
+	cfg.AuthAPIKey = os.Getenv(envServerAuthAPIKey)
+	cfg.AuthAPIKeyHeader = os.Getenv(envServerAuthAPIKeyHeader)
+	if len(cfg.AuthAPIKeyHeader) == 0 {
+		cfg.AuthAPIKeyHeader = DefaultAPIKeyHeader
+	}
+}
+
+// String impl
+func (cfg *ServerAppConfig) String() string {
+	return fmt.Sprintf(
+		"---Server Configuration---\nHost:%s\ngRPC Port:%s\nHTTP Port:%s\nStore Path:%s\nAuth Required:%t\n",
+		cfg.Host,
+		cfg.GRPCPort,
+		cfg.HTTPPort,
+		cfg.StorePath,
+		len(cfg.AuthToken) > 0 || len(cfg.AuthAPIKey) > 0,
+	)
+}
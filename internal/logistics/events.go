@@ -0,0 +1,65 @@
+package internal
+
+import (
+	"time"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+)
+
+// RequestEvent of request sent to API, observed with OnRequest
+type RequestEvent struct {
+	// Operation like MoveUnit or UnitReachedWarehouse
+	Operation   string
+	CargoUnitID int64
+	// WarehouseID that cargo unit reached, zero for MoveUnit
+	WarehouseID         int64
+	Latitude, Longitude uint32
+	Sequence            uint64
+	SentAt              time.Time
+	// Latency of request including retries
+	Latency time.Duration
+	// Err of failed request, nil on success
+	Err error
+}
+
+// OnRequest sets observer of every sent request, it is called concurrently from goroutines that sent them
+func (s *ServiceInstance) OnRequest(observer func(RequestEvent)) {
+	s.onRequest = observer
+}
+
+// notifyMoveUnit observer about req sent at sentAt
+func (s *ServiceInstance) notifyMoveUnit(req *apiv1.MoveUnitRequest, sentAt time.Time, sendErr error) {
+	if s.onRequest == nil {
+		return
+	}
+
+	s.onRequest(RequestEvent{
+		Operation:   s.statistics.Operation[0].Name,
+		CargoUnitID: req.GetCargoUnitId(),
+		Latitude:    req.GetLocation().GetLatitude(),
+		Longitude:   req.GetLocation().GetLongitude(),
+		Sequence:    req.GetSequence(),
+		SentAt:      sentAt,
+		Latency:     time.Since(sentAt),
+		Err:         sendErr,
+	})
+}
+
+// notifyUnitReachedWarehouse observer about req sent at sentAt
+func (s *ServiceInstance) notifyUnitReachedWarehouse(req *apiv1.UnitReachedWarehouseRequest, sentAt time.Time, sendErr error) {
+	if s.onRequest == nil {
+		return
+	}
+
+	s.onRequest(RequestEvent{
+		Operation:   s.statistics.Operation[1].Name,
+		CargoUnitID: req.GetAnnouncement().GetCargoUnitId(),
+		WarehouseID: req.GetAnnouncement().GetWarehouseId(),
+		Latitude:    req.GetLocation().GetLatitude(),
+		Longitude:   req.GetLocation().GetLongitude(),
+		Sequence:    req.GetSequence(),
+		SentAt:      sentAt,
+		Latency:     time.Since(sentAt),
+		Err:         sendErr,
+	})
+}
@@ -0,0 +1,20 @@
+package model
+
+// DeliveryPath of cargo unit in client run, ordered sequence of visited locations and warehouse it reached
+type DeliveryPath struct {
+    // RunID of client run, cargo unit IDs start over in every run
+    RunID       string
+    CargoUnitID int64
+    Path        []Coordinate
+    // WarehouseID is set when Reached is true
+    WarehouseID int64
+    Reached     bool
+}
+
+// Copy of delivery path that not shares Path with original
+func (dp *DeliveryPath) Copy() DeliveryPath {
+    c := *dp
+    c.Path = append([]Coordinate(nil), dp.Path...)
+
+    return c
+}
@@ -2,7 +2,7 @@ package model
 
 import "sync"
 
-// Graph model
+// Graph model, nodes are read and updated under the lock and returned as copies
 type Graph struct {
     Nodes []GraphNode
     Edges []GraphEdge
@@ -52,26 +52,27 @@ func (g *Graph) AddEdge(edge GraphEdge) {
     }
 }
 
-// GetNodeByID returns the node with the specified ID, or nil if it is not found
+// GetNodeByID returns copy of the node with the specified ID, or nil if it is not found
 func (g *Graph) GetNodeByID(nodeID uint) *GraphNode {
-    for _, node := range g.Nodes {
-        if node.ID == nodeID {
-            return &node
-        }
-    }
-    return nil
+    g.Lock()
+    defer g.Unlock()
+
+    return g.nodeByID(nodeID)
 }
 
-// GetNodesByType returns a slice of nodes with the specified type
+// GetNodesByType returns a slice of copies of nodes with the specified type
 func (g *Graph) GetNodesByType(nodeType any) []*GraphNode {
+    g.Lock()
+    defer g.Unlock()
+
     var nodesByType []*GraphNode
 
     for _, node := range g.Nodes {
         if node.Type == nodeType {
-            copyNode := node
+            copyNode := node.copy()
 
-            if !containsNode(nodesByType, &copyNode) {
-                nodesByType = append(nodesByType, &copyNode)
+            if !containsNode(nodesByType, copyNode) {
+                nodesByType = append(nodesByType, copyNode)
             }
         }
     }
@@ -79,20 +80,23 @@ func (g *Graph) GetNodesByType(nodeType any) []*GraphNode {
     return nodesByType
 }
 
-// GetConnectedNodes returns a slice of connected nodes of the given type to the node with the specified ID
+// GetConnectedNodes returns a slice of copies of connected nodes of the given type to the node with the specified ID
 func (g *Graph) GetConnectedNodes(nodeID uint, nodeType any) []*GraphNode {
+    g.Lock()
+    defer g.Unlock()
+
     var connectedNodes []*GraphNode
 
     for _, edge := range g.Edges {
         if edge.Source == nodeID {
-            targetNode := g.GetNodeByID(edge.Target)
+            targetNode := g.nodeByID(edge.Target)
             if targetNode != nil && targetNode.Type == nodeType {
                 if !containsNode(connectedNodes, targetNode) {
                     connectedNodes = append(connectedNodes, targetNode)
                 }
             }
         } else if edge.Target == nodeID {
-            sourceNode := g.GetNodeByID(edge.Source)
+            sourceNode := g.nodeByID(edge.Source)
             if sourceNode != nil && sourceNode.Type == nodeType {
                 if !containsNode(connectedNodes, sourceNode) {
                     connectedNodes = append(connectedNodes, sourceNode)
@@ -104,21 +108,60 @@ func (g *Graph) GetConnectedNodes(nodeID uint, nodeType any) []*GraphNode {
     return connectedNodes
 }
 
-// FindNodesByLocation node in given coordinate
+// FindNodesByLocation copy of node in given coordinate
 func (g *Graph) FindNodesByLocation(coordinate Coordinate, nodeType any) *GraphNode {
+    g.Lock()
+    defer g.Unlock()
+
     for _, node := range g.Nodes {
         if node.Type != nodeType {
             continue
         }
 
-        if *node.Coordinate == coordinate {
-            return &node
+        if node.Coordinate != nil && *node.Coordinate == coordinate {
+            return node.copy()
         }
     }
 
     return nil
 }
 
+// UpdateNode with the specified ID in place, update must not call methods of the graph.
+// Returns false if node is not found.
+func (g *Graph) UpdateNode(nodeID uint, update func(node *GraphNode)) bool {
+    g.Lock()
+    defer g.Unlock()
+
+    for i := range g.Nodes {
+        if g.Nodes[i].ID == nodeID {
+            update(&g.Nodes[i])
+            return true
+        }
+    }
+
+    return false
+}
+
+// nodeByID copy, caller must hold the lock
+func (g *Graph) nodeByID(nodeID uint) *GraphNode {
+    for _, node := range g.Nodes {
+        if node.ID == nodeID {
+            return node.copy()
+        }
+    }
+    return nil
+}
+
+// copy of node that does not share Coordinate with original, so it can be read while original moves
+func (n GraphNode) copy() *GraphNode {
+    if n.Coordinate != nil {
+        coordinate := *n.Coordinate
+        n.Coordinate = &coordinate
+    }
+
+    return &n
+}
+
 func containsNode(nodes []*GraphNode, node *GraphNode) bool {
     for _, n := range nodes {
         if n.ID == node.ID {
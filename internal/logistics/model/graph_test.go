@@ -1,6 +1,7 @@
 package model
 
 import (
+    "sync"
     "testing"
 )
 
@@ -76,3 +77,57 @@ func TestGetConnectedNodes(t *testing.T) {
         t.Errorf("Expected connected node ID %d, but got %d", expectedNodeID, connectedNodes[0].ID)
     }
 }
+
+func TestGraphReturnsCopies(t *testing.T) {
+    graph := NewGraph()
+    graph.AddNode(GraphNode{ID: 1, Type: "Unit", Coordinate: &Coordinate{X: 1, Y: 1}})
+
+    node := graph.GetNodeByID(1)
+    node.X = 5
+    node.Metadata = true
+
+    if stored := graph.GetNodeByID(1); stored.X != 1 || stored.Metadata != nil {
+        t.Errorf("Expected change of copy not to update graph, but got %+v at %v", stored, *stored.Coordinate)
+    }
+
+    found := graph.UpdateNode(1, func(node *GraphNode) {
+        node.X++
+    })
+    if !found || graph.GetNodesByType("Unit")[0].X != 2 || node.X != 5 {
+        t.Errorf("Expected update of graph node not to change copies")
+    }
+    if graph.UpdateNode(2, func(*GraphNode) {}) {
+        t.Errorf("Expected update of missing node to return false")
+    }
+}
+
+func TestGraphConcurrentUpdates(t *testing.T) {
+    const updates = 100
+
+    graph := NewGraph()
+    graph.AddNode(GraphNode{ID: 1, Type: "Unit", Coordinate: &Coordinate{}})
+    graph.AddNode(GraphNode{ID: 2, Type: "Warehouse", Coordinate: &Coordinate{X: 3, Y: 3}})
+    graph.AddEdge(GraphEdge{Source: 1, Target: 2})
+
+    var wg sync.WaitGroup
+    for i := 0; i < updates; i++ {
+        wg.Add(2)
+        go func() {
+            defer wg.Done()
+            graph.UpdateNode(1, func(node *GraphNode) {
+                node.X++
+            })
+        }()
+        go func() {
+            defer wg.Done()
+            _ = graph.GetNodeByID(1).X
+            _ = graph.GetConnectedNodes(1, "Warehouse")
+            _ = graph.FindNodesByLocation(Coordinate{X: 3, Y: 3}, "Warehouse")
+        }()
+    }
+    wg.Wait()
+
+    if x := graph.GetNodeByID(1).X; x != updates {
+        t.Errorf("Expected %d applied updates, but got %d", updates, x)
+    }
+}
@@ -2,13 +2,82 @@ package model
 
 import (
     "sync"
+    "sync/atomic"
     "time"
+
+    "github.com/coopnorge/interview-backend/internal/pkg/histogram"
 )
 
 // Statistics about world operations
 type Statistics struct {
     Operation []*Operation
     ExecTime  time.Time
+    // Units statistics by cargo unit ID, must be filled before concurrent use
+    Units map[int64]*UnitStatistics
+    // Schedule of open loop run, nil in closed loop
+    Schedule *ScheduleStatistics
+    // Stages of load profile named by their spec, A is sent requests and B errors, nil without profile
+    Stages []*Operation
+}
+
+// ScheduleStatistics of requests sent at target rate
+type ScheduleStatistics struct {
+    TargetRate float64
+    // Sent requests and how long it took to send them
+    Sent     uint64
+    Duration time.Duration
+    // Missed send deadlines, requests sent later than their tolerance
+    Missed      uint64
+    MaxLateness time.Duration
+}
+
+// AchievedRate of requests per second
+func (s *ScheduleStatistics) AchievedRate() float64 {
+    if s.Duration <= 0 {
+        return 0
+    }
+
+    return float64(s.Sent) / s.Duration.Seconds()
+}
+
+// AddLateness of sent request, counted as missed deadline if above tolerance
+func (s *ScheduleStatistics) AddLateness(lateness, tolerance time.Duration) {
+    s.Sent++
+    if lateness > s.MaxLateness {
+        s.MaxLateness = lateness
+    }
+    if lateness > tolerance {
+        s.Missed++
+    }
+}
+
+// UnitStatistics of messages sent about single cargo unit
+type UnitStatistics struct {
+    MoveUnit             Operation
+    UnitReachedWarehouse Operation
+
+    sequence    atomic.Uint64
+    deliveredAt atomic.Int64
+}
+
+// MarkDelivered when server accepted announcement that unit reached warehouse, first time is kept
+func (u *UnitStatistics) MarkDelivered(at time.Time) {
+    u.deliveredAt.CompareAndSwap(0, at.UnixNano())
+}
+
+// DeliveredAt time when unit reached warehouse, zero if it did not
+func (u *UnitStatistics) DeliveredAt() time.Time {
+    deliveredAt := u.deliveredAt.Load()
+    if deliveredAt == 0 {
+        return time.Time{}
+    }
+
+    return time.Unix(0, deliveredAt)
+}
+
+// NextSequence of message about cargo unit, shared by all operations and starts from 1
+func (u *UnitStatistics) NextSequence() uint64 {
+    return u.sequence.Add(1)
 }
 
 // Operation kind
@@ -16,6 +85,10 @@ type Operation struct {
     Name string
     A    uint64
     B    uint64
+    // Latency from sending request until response
+    Latency histogram.Histogram
+    // CorrectedLatency from scheduled send time until response, corrects coordinated omission of open loop
+    CorrectedLatency histogram.Histogram
 
     sync.Mutex
 }
@@ -33,3 +106,26 @@ func (o *Operation) AddB() {
     defer o.Unlock()
     o.B++
 }
+
+// Total number of operations
+func (o *Operation) Total() uint64 {
+    o.Lock()
+    defer o.Unlock()
+    return o.A
+}
+
+// RecordLatency of request sent at sentAt, scheduledAt is zero if request was not scheduled
+func (o *Operation) RecordLatency(scheduledAt, sentAt time.Time) {
+    now := time.Now()
+    o.Latency.Record(now.Sub(sentAt))
+    if !scheduledAt.IsZero() {
+        o.CorrectedLatency.Record(now.Sub(scheduledAt))
+    }
+}
+
+// Succeeded number of operations, sent without error
+func (o *Operation) Succeeded() uint64 {
+    o.Lock()
+    defer o.Unlock()
+    return o.A - o.B
+}
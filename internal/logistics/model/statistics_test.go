@@ -0,0 +1,43 @@
+package model
+
+import (
+    "testing"
+    "time"
+)
+
+func TestScheduleStatistics(t *testing.T) {
+    schedule := &ScheduleStatistics{TargetRate: 10}
+
+    schedule.AddLateness(0, time.Millisecond)
+    schedule.AddLateness(time.Millisecond, time.Millisecond)
+    schedule.AddLateness(5*time.Millisecond, time.Millisecond)
+    schedule.Duration = 300 * time.Millisecond
+
+    if schedule.Sent != 3 {
+        t.Errorf("Expected 3 sent requests, but got %d", schedule.Sent)
+    }
+    if schedule.Missed != 1 {
+        t.Errorf("Expected 1 missed deadline, but got %d", schedule.Missed)
+    }
+    if schedule.MaxLateness != 5*time.Millisecond {
+        t.Errorf("Expected max lateness 5ms, but got %s", schedule.MaxLateness)
+    }
+    if rate := schedule.AchievedRate(); rate != 10 {
+        t.Errorf("Expected achieved rate 10, but got %f", rate)
+    }
+}
+
+func TestUnitStatisticsMarkDelivered(t *testing.T) {
+    unit := &UnitStatistics{}
+    if !unit.DeliveredAt().IsZero() {
+        t.Errorf("Expected unit not to be delivered, but got %s", unit.DeliveredAt())
+    }
+
+    first := time.Now()
+    unit.MarkDelivered(first)
+    unit.MarkDelivered(first.Add(time.Second))
+
+    if !unit.DeliveredAt().Equal(first) {
+        t.Errorf("Expected first delivery time %s, but got %s", first, unit.DeliveredAt())
+    }
+}
@@ -0,0 +1,191 @@
+package internal
+
+import (
+	"sync"
+	"time"
+
+	"github.com/coopnorge/interview-backend/internal/logistics/model"
+)
+
+const (
+	// openLoopMaxInFlight requests unless configured, scheduler waits for free slot and the wait is counted as lateness
+	openLoopMaxInFlight = 1 << 12
+	// minSendDeadlineTolerance below which lateness is timer resolution rather than missed deadline
+	minSendDeadlineTolerance = time.Millisecond
+	// openLoopPauseStep between checks if load profile with zero rate resumed
+	openLoopPauseStep = 10 * time.Millisecond
+)
+
+// openLoopUnit is cargo unit scheduled in open loop
+type openLoopUnit struct {
+	*model.GraphNode
+	// arrived at warehouse in coordinate, next request of the unit announces it
+	arrived    bool
+	coordinate model.Coordinate
+}
+
+// openLoopQueue of cargo units waiting for their slot, unit whose announcement failed returns to it
+type openLoopQueue struct {
+	sync.Mutex
+	pending []*openLoopUnit
+	// announcing units with announcement in flight, queue is not done until they are announced
+	announcing int
+}
+
+// pop next unit waiting for slot, false if none is waiting
+func (q *openLoopQueue) pop() (*openLoopUnit, bool) {
+	q.Lock()
+	defer q.Unlock()
+
+	if len(q.pending) == 0 {
+		return nil, false
+	}
+	unit := q.pending[0]
+	q.pending = q.pending[1:]
+	if unit.arrived {
+		q.announcing++
+	}
+
+	return unit, true
+}
+
+// push unit back to wait for its next slot
+func (q *openLoopQueue) push(unit *openLoopUnit) {
+	q.Lock()
+	defer q.Unlock()
+
+	q.pending = append(q.pending, unit)
+}
+
+// announced unit with announceErr, unit returns to queue to announce again if it failed
+func (q *openLoopQueue) announced(unit *openLoopUnit, announceErr error) {
+	q.Lock()
+	defer q.Unlock()
+
+	q.announcing--
+	if announceErr != nil {
+		q.pending = append(q.pending, unit)
+	}
+}
+
+// done when every unit announced it reached warehouse
+func (q *openLoopQueue) done() bool {
+	q.Lock()
+	defer q.Unlock()
+
+	return len(q.pending) == 0 && q.announcing == 0
+}
+
+// runOpenLoop sends one request per slot at configured rate, or rate of load profile, regardless of response time.
+// Cargo units take slots in turn until every unit announced reached warehouse or profile ended,
+// unit whose announcement failed takes another slot to announce again.
+func (s *ServiceInstance) runOpenLoop() {
+	schedule := &model.ScheduleStatistics{TargetRate: s.load.Rate}
+	s.statistics.Schedule = schedule
+
+	queue := &openLoopQueue{}
+	for _, unit := range s.worldOperator.GetDeliveryUnit() {
+		queue.push(&openLoopUnit{GraphNode: unit})
+	}
+
+	var wg sync.WaitGroup
+	maxInFlight := s.load.MaxInFlight
+	if maxInFlight == 0 {
+		maxInFlight = openLoopMaxInFlight
+	}
+	inFlight := make(chan struct{}, maxInFlight)
+
+	deadline := s.loadStart
+	for !queue.done() && s.waitUntil(deadline) {
+		rate, active := s.rateAt(deadline.Sub(s.loadStart))
+		if !active {
+			break
+		}
+		if rate <= 0 { // Paused by profile
+			deadline = deadline.Add(openLoopPauseStep)
+			continue
+		}
+		interval := time.Duration(float64(time.Second) / rate)
+
+		unit, ok := queue.pop()
+		if !ok { // Every waiting unit is announcing, slot is taken only if its announcement fails
+			deadline = deadline.Add(max(interval, openLoopPauseStep))
+			continue
+		}
+
+		select {
+		case <-s.stopCtx.Done():
+		case inFlight <- struct{}{}:
+		}
+		if s.stopCtx.Err() != nil {
+			break
+		}
+		schedule.AddLateness(time.Since(deadline), max(interval, minSendDeadlineTolerance))
+
+		send, announced := s.openLoopStep(unit, deadline)
+		if !announced {
+			queue.push(unit)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-inFlight }()
+
+			sendErr := send()
+			if announced {
+				if sendErr == nil {
+					s.worldOperator.MarkDelivered(unit.ID)
+				}
+				queue.announced(unit, sendErr)
+			}
+		}()
+
+		deadline = deadline.Add(interval)
+	}
+	schedule.Duration = time.Since(s.loadStart)
+	if s.profile != nil {
+		schedule.TargetRate = s.profile.Mean(schedule.Duration)
+	}
+
+	wg.Wait()
+}
+
+// rateAt elapsed time of run, inactive when load profile ended
+func (s *ServiceInstance) rateAt(elapsed time.Duration) (float64, bool) {
+	if s.profile == nil {
+		return s.load.Rate, true
+	}
+
+	rate, stage := s.profile.At(elapsed)
+
+	return rate, stage >= 0
+}
+
+// waitUntil deadline, false if service is stopped
+func (s *ServiceInstance) waitUntil(deadline time.Time) bool {
+	if wait := time.Until(deadline); wait > 0 {
+		select {
+		case <-s.stopCtx.Done():
+		case <-time.After(wait):
+		}
+	}
+
+	return s.stopCtx.Err() == nil
+}
+
+// openLoopStep moves unit in the world and returns its request scheduled at deadline, or announcement if unit
+// arrived in previous step. Moves, their sequences and event times are computed by scheduler, so unit keeps moving
+// in order while its previous requests are still in flight.
+func (s *ServiceInstance) openLoopStep(unit *openLoopUnit, deadline time.Time) (send func() error, announced bool) {
+	if unit.arrived {
+		reached := s.newUnitEvent(unit.GraphNode, unit.coordinate)
+		return func() error { return s.sendUnitReachedWarehouse(unit.GraphNode, reached, deadline) }, true
+	}
+
+	newCoordinate, arrived := s.worldOperator.MoveDeliveryUnitToNearestWarehouse(unit.ID)
+	unit.arrived, unit.coordinate = arrived, newCoordinate
+	move := s.newUnitEvent(unit.GraphNode, newCoordinate)
+
+	return func() error { return s.sendMoveUnit(unit.GraphNode, move, deadline) }, false
+}
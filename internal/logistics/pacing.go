@@ -0,0 +1,35 @@
+package internal
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// initialMoveWait of cargo units in microseconds
+const initialMoveWait = 100
+
+// movePacer spreads moves of cargo units in closed loop with random waits, shared by all units and safe for
+// concurrent use. Random source must not be used by others while pacer is in use.
+type movePacer struct {
+	maxWait int
+	random  *rand.Rand
+
+	sync.Mutex
+}
+
+// newMovePacer drawing waits from random
+func newMovePacer(random *rand.Rand) *movePacer {
+	return &movePacer{maxWait: initialMoveWait, random: random}
+}
+
+// Next wait before move, next waits are random and at most half of the previous one plus one microsecond
+func (p *movePacer) Next() time.Duration {
+	p.Lock()
+	defer p.Unlock()
+
+	wait := time.Duration(p.maxWait) * time.Microsecond
+	p.maxWait = (p.random.Intn(p.maxWait+1) + 1) >> 1
+
+	return wait
+}
@@ -0,0 +1,207 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+	"github.com/coopnorge/interview-backend/internal/logistics/model"
+)
+
+// profileControlInterval how often concurrency limit follows load profile
+const profileControlInterval = 100 * time.Millisecond
+
+// ErrProfileCutShort returned by Run that delivered every cargo unit before load profile ended,
+// so remaining stages were never sent
+var ErrProfileCutShort = errors.New("load profile cut short")
+
+// activeStage statistics of load profile stage at current time, nil without profile or after it ended
+func (s *ServiceInstance) activeStage() *model.Operation {
+	if s.profile == nil {
+		return nil
+	}
+
+	_, stage := s.profile.At(time.Since(s.loadStart))
+	if stage < 0 {
+		return nil
+	}
+
+	return s.statistics.Stages[stage]
+}
+
+// profileEnded if load profile is set and all its stages passed
+func (s *ServiceInstance) profileEnded() bool {
+	if s.profile == nil {
+		return false
+	}
+
+	_, stage := s.profile.At(time.Since(s.loadStart))
+	return stage < 0
+}
+
+// checkProfileCompleted of finished load, ErrProfileCutShort if load ended before the profile did
+func (s *ServiceInstance) checkProfileCompleted() error {
+	if s.profile == nil || s.loadDuration >= s.profile.Duration() {
+		return nil
+	}
+
+	_, stage := s.profile.At(s.loadDuration)
+	return fmt.Errorf(
+		"%s, %w: every cargo unit was delivered after %s of %s in stage %d/%d, world must be larger to outlast the profile",
+		appName,
+		ErrProfileCutShort,
+		s.loadDuration.Round(time.Millisecond),
+		s.profile.Duration(),
+		stage+1,
+		len(s.profile.Stages),
+	)
+}
+
+// followProfile logs active stage every second and keeps gate limit at profile value until ctx is done
+func (s *ServiceInstance) followProfile(ctx context.Context, gate *concurrencyGate) {
+	control := time.NewTicker(profileControlInterval)
+	defer control.Stop()
+	progress := time.NewTicker(time.Second)
+	defer progress.Stop()
+
+	var lastSent uint64
+	for {
+		select {
+		case <-ctx.Done():
+			gate.Close()
+			return
+		case <-control.C:
+			value, stage := s.profile.At(time.Since(s.loadStart))
+			if stage < 0 {
+				gate.Close()
+				continue
+			}
+			gate.SetLimit(int(math.Round(value)))
+		case <-progress.C:
+			value, stage := s.profile.At(time.Since(s.loadStart))
+			if stage < 0 {
+				continue
+			}
+
+			var sent uint64
+			for _, o := range s.statistics.Operation {
+				sent += o.Total()
+			}
+			s.logger.Printf(
+				"%s, load stage %d/%d %s, target %.1f %s, sent %d requests in last second\n",
+				appName,
+				stage+1,
+				len(s.profile.Stages),
+				s.statistics.Stages[stage].Name,
+				value,
+				s.load.ProfileTarget,
+				sent-lastSent,
+			)
+			lastSent = sent
+		}
+	}
+}
+
+// countInStage request sent in stage of load profile, nil stage is ignored
+func countInStage(stage *model.Operation, sendErr error) {
+	if stage == nil {
+		return
+	}
+
+	stage.AddA()
+	if sendErr != nil {
+		stage.AddB()
+	}
+}
+
+// concurrencyGate limits concurrent requests to limit that changes over time, nil gate does not limit
+type concurrencyGate struct {
+	inFlight, limit int
+	// maxLimit caps limit set by load profile, 0 does not cap it
+	maxLimit int
+	closed   bool
+	cond     *sync.Cond
+
+	sync.Mutex
+}
+
+// newConcurrencyGate of closed loop for load profile that controls concurrency or limited in-flight requests,
+// nil for other loads
+func newConcurrencyGate(cfg config.ClientLoadConfig) *concurrencyGate {
+	profiled := len(cfg.Profile) > 0 && cfg.ProfileTarget == config.LoadTargetConcurrency
+	if cfg.IsOpenLoop() || (!profiled && cfg.MaxInFlight == 0) {
+		return nil
+	}
+
+	gate := &concurrencyGate{limit: cfg.MaxInFlight, maxLimit: cfg.MaxInFlight}
+	gate.cond = sync.NewCond(&gate.Mutex)
+
+	return gate
+}
+
+// Acquire slot for request, blocks while limit is reached and returns false if gate is closed
+func (g *concurrencyGate) Acquire() bool {
+	if g == nil {
+		return true
+	}
+
+	g.Lock()
+	defer g.Unlock()
+
+	for !g.closed && g.inFlight >= g.limit {
+		g.cond.Wait()
+	}
+	if g.closed {
+		return false
+	}
+
+	g.inFlight++
+
+	return true
+}
+
+// Release slot of finished request
+func (g *concurrencyGate) Release() {
+	if g == nil {
+		return
+	}
+
+	g.Lock()
+	defer g.Unlock()
+
+	g.inFlight--
+	g.cond.Broadcast()
+}
+
+// SetLimit of concurrent requests up to max limit, lowered limit lets in-flight requests finish
+func (g *concurrencyGate) SetLimit(limit int) {
+	if g == nil {
+		return
+	}
+
+	g.Lock()
+	defer g.Unlock()
+
+	g.limit = limit
+	if g.maxLimit > 0 && limit > g.maxLimit {
+		g.limit = g.maxLimit
+	}
+	g.cond.Broadcast()
+}
+
+// Close gate, waiting and next Acquire return false
+func (g *concurrencyGate) Close() {
+	if g == nil {
+		return
+	}
+
+	g.Lock()
+	defer g.Unlock()
+
+	g.closed = true
+	g.cond.Broadcast()
+}
@@ -0,0 +1,122 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/client"
+	"github.com/coopnorge/interview-backend/internal/pkg/printer"
+)
+
+const reconciliationTimeout = 30 * time.Second
+
+// ErrReconciliationMismatch when server received different number of messages than client successfully sent
+var ErrReconciliationMismatch = errors.New("server received different number of messages than client sent")
+
+// unitReconciliation compares messages client sent without error with messages server accepted
+type unitReconciliation struct {
+	cargoUnitID int64
+
+	moveUnitSent, moveUnitReceived                         uint64
+	unitReachedWarehouseSent, unitReachedWarehouseReceived uint64
+}
+
+func (r *unitReconciliation) matches() bool {
+	return r.moveUnitSent == r.moveUnitReceived && r.unitReachedWarehouseSent == r.unitReachedWarehouseReceived
+}
+
+func (r *unitReconciliation) row(name string) []string {
+	return []string{
+		name,
+		strconv.FormatUint(r.moveUnitSent, 10),
+		strconv.FormatUint(r.moveUnitReceived, 10),
+		strconv.FormatUint(r.unitReachedWarehouseSent, 10),
+		strconv.FormatUint(r.unitReachedWarehouseReceived, 10),
+	}
+}
+
+// reconcile messages sent by client with counts reported by server,
+// prints diff table and returns ErrReconciliationMismatch if any cargo unit does not match
+// or client.ErrNotSupported if server does not report counts.
+func (s *ServiceInstance) reconcile() error {
+	ctx, ctxCancel := context.WithTimeout(s.ctx, reconciliationTimeout)
+	defer ctxCancel()
+
+	received, countsErr := s.logisticsClient.GetReceivedCounts(ctx)
+	if errors.Is(countsErr, client.ErrNotSupported) {
+		s.logger.Printf("%s, server does not report received counts, reconciliation skipped: %v\n", appName, countsErr)
+		return fmt.Errorf("%s, reconciliation skipped: %w", appName, countsErr)
+	} else if countsErr != nil {
+		return fmt.Errorf("%s, failed to get received counts from API, error: %w", appName, countsErr)
+	}
+
+	mismatches, total := s.compareWithReceived(received)
+
+	table := printer.NewASCIITablePrinter()
+	table.AddHeader([]string{"Cargo Unit", "MoveUnit Sent", "MoveUnit Received", "UnitReachedWarehouse Sent", "UnitReachedWarehouse Received"})
+	for _, m := range mismatches {
+		table.AddRow(m.row(strconv.FormatInt(m.cargoUnitID, 10)))
+	}
+	table.AddRow(total.row("Total"))
+
+	fmt.Fprintf(s.out, "\nReconciliation, %d of %d cargo units mismatch:\n", len(mismatches), len(s.statistics.Units))
+	fmt.Fprintln(s.out, table)
+
+	var outOfOrder, duplicates uint64
+	for _, counts := range received {
+		outOfOrder += counts.GetOutOfOrderCount()
+		duplicates += counts.GetDuplicateCount()
+	}
+	fmt.Fprintf(s.out, "Server received %d messages out of order and %d duplicates\n", outOfOrder, duplicates)
+
+	if len(mismatches) > 0 {
+		return fmt.Errorf("%s, %w: %d cargo units mismatch", appName, ErrReconciliationMismatch, len(mismatches))
+	}
+
+	return nil
+}
+
+// compareWithReceived returns mismatching cargo units ordered by ID and totals of all units
+func (s *ServiceInstance) compareWithReceived(received []*apiv1.CargoUnitReceivedCounts) ([]*unitReconciliation, *unitReconciliation) {
+	units := make(map[int64]*unitReconciliation, len(s.statistics.Units))
+	for cargoUnitID, unitStatistics := range s.statistics.Units {
+		units[cargoUnitID] = &unitReconciliation{
+			cargoUnitID:              cargoUnitID,
+			moveUnitSent:             unitStatistics.MoveUnit.Succeeded(),
+			unitReachedWarehouseSent: unitStatistics.UnitReachedWarehouse.Succeeded(),
+		}
+	}
+
+	// Server may report units client never sent, e.g. if it counts messages under wrong cargo unit
+	for _, counts := range received {
+		unit, ok := units[counts.GetCargoUnitId()]
+		if !ok {
+			unit = &unitReconciliation{cargoUnitID: counts.GetCargoUnitId()}
+			units[counts.GetCargoUnitId()] = unit
+		}
+
+		unit.moveUnitReceived = counts.GetMoveUnitCount()
+		unit.unitReachedWarehouseReceived = counts.GetUnitReachedWarehouseCount()
+	}
+
+	total := &unitReconciliation{}
+	var mismatches []*unitReconciliation
+	for _, unit := range units {
+		total.moveUnitSent += unit.moveUnitSent
+		total.moveUnitReceived += unit.moveUnitReceived
+		total.unitReachedWarehouseSent += unit.unitReachedWarehouseSent
+		total.unitReachedWarehouseReceived += unit.unitReachedWarehouseReceived
+
+		if !unit.matches() {
+			mismatches = append(mismatches, unit)
+		}
+	}
+	sort.Slice(mismatches, func(i, j int) bool { return mismatches[i].cargoUnitID < mismatches[j].cargoUnitID })
+
+	return mismatches, total
+}
@@ -0,0 +1,137 @@
+package internal
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+	"github.com/coopnorge/interview-backend/internal/logistics/model"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/client"
+)
+
+// replayMaxInFlight requests while replaying, keeps fast replay from exhausting connections
+const replayMaxInFlight = 1 << 10
+
+// loadReplay records and prepare statistics of every cargo unit in them
+func (s *ServiceInstance) loadReplay(cfg config.ClientTrafficConfig) error {
+	records, readErr := client.ReadTraffic(cfg.ReplayPath)
+	if readErr != nil {
+		return fmt.Errorf("%s, failed to load replay, error: %w", appName, readErr)
+	}
+	if len(records) == 0 {
+		return fmt.Errorf("%s, failed to load replay, no records in %s", appName, cfg.ReplayPath)
+	}
+
+	s.replayRecords = records
+	s.replaySpeed = cfg.ReplaySpeed
+	s.statistics.Units = make(map[int64]*model.UnitStatistics)
+	for _, record := range records {
+		cargoUnitID := replayCargoUnitID(record)
+		if _, ok := s.statistics.Units[cargoUnitID]; !ok {
+			s.statistics.Units[cargoUnitID] = &model.UnitStatistics{}
+		}
+	}
+
+	s.logger.Printf("%s, loaded %d recorded requests of %d cargo units from %s\n", appName, len(records), len(s.statistics.Units), cfg.ReplayPath)
+
+	return nil
+}
+
+// replay recorded requests at recorded offsets divided by replay speed, speed 0 sends them as fast as possible
+func (s *ServiceInstance) replay() {
+	var wg sync.WaitGroup
+	inFlight := make(chan struct{}, replayMaxInFlight)
+	// Requests of the same cargo unit are sent in recorded order, each waits for previous one to complete
+	unitPrevious := make(map[int64]chan struct{}, len(s.statistics.Units))
+
+	start := time.Now()
+	for _, record := range s.replayRecords {
+		if wait := replayDelay(record, s.replaySpeed) - time.Since(start); wait > 0 {
+			select {
+			case <-s.stopCtx.Done():
+			case <-time.After(wait):
+			}
+		}
+		if s.stopCtx.Err() != nil {
+			break
+		}
+
+		select {
+		case <-s.stopCtx.Done():
+		case inFlight <- struct{}{}:
+		}
+		if s.stopCtx.Err() != nil {
+			break
+		}
+
+		cargoUnitID := replayCargoUnitID(record)
+		previous, done := unitPrevious[cargoUnitID], make(chan struct{})
+		unitPrevious[cargoUnitID] = done
+
+		wg.Add(1)
+		go func(record *apiv1.TrafficRecord) {
+			defer wg.Done()
+			defer func() { <-inFlight }()
+			defer close(done)
+
+			if previous != nil {
+				<-previous
+			}
+			s.replayRecord(record)
+		}(record)
+	}
+
+	wg.Wait()
+	s.logger.Printf("%s, replay finished in %s\n", appName, time.Since(start))
+}
+
+// replayRecord as it was recorded, with the same sequence, event time and idempotency key, in run of this client
+func (s *ServiceInstance) replayRecord(record *apiv1.TrafficRecord) {
+	unitStatistics := s.statistics.Units[replayCargoUnitID(record)]
+
+	switch request := record.GetRequest().(type) {
+	case *apiv1.TrafficRecord_MoveUnit:
+		s.statistics.Operation[0].AddA()
+		unitStatistics.MoveUnit.AddA()
+		sentAt := time.Now()
+		moveErr := s.logisticsClient.MoveUnit(s.requestCtx, request.MoveUnit)
+		s.statistics.Operation[0].RecordLatency(time.Time{}, sentAt)
+		s.notifyMoveUnit(request.MoveUnit, sentAt, moveErr)
+		if moveErr != nil {
+			s.logger.Printf("filed to replay MoveUnit of cargo unit %d, API error: %v\n", request.MoveUnit.GetCargoUnitId(), moveErr)
+			s.statistics.Operation[0].AddB()
+			unitStatistics.MoveUnit.AddB()
+		}
+	case *apiv1.TrafficRecord_UnitReachedWarehouse:
+		s.statistics.Operation[1].AddA()
+		unitStatistics.UnitReachedWarehouse.AddA()
+		sentAt := time.Now()
+		reachErr := s.logisticsClient.UnitReachedWarehouse(s.requestCtx, request.UnitReachedWarehouse)
+		s.statistics.Operation[1].RecordLatency(time.Time{}, sentAt)
+		s.notifyUnitReachedWarehouse(request.UnitReachedWarehouse, sentAt, reachErr)
+		if reachErr != nil {
+			s.logger.Printf("filed to replay UnitReachedWarehouse of cargo unit %d, API error: %v\n", request.UnitReachedWarehouse.GetAnnouncement().GetCargoUnitId(), reachErr)
+			s.statistics.Operation[1].AddB()
+			unitStatistics.UnitReachedWarehouse.AddB()
+		}
+	}
+}
+
+// replayDelay of record from start of replay
+func replayDelay(record *apiv1.TrafficRecord, speed float64) time.Duration {
+	if speed <= 0 {
+		return 0
+	}
+
+	return time.Duration(float64(record.GetOffset().AsDuration()) / speed)
+}
+
+func replayCargoUnitID(record *apiv1.TrafficRecord) int64 {
+	if reached := record.GetUnitReachedWarehouse(); reached != nil {
+		return reached.GetAnnouncement().GetCargoUnitId()
+	}
+
+	return record.GetMoveUnit().GetCargoUnitId()
+}
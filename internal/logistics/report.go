@@ -0,0 +1,207 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/coopnorge/interview-backend/internal/logistics/report"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/client"
+	"github.com/coopnorge/interview-backend/internal/pkg/histogram"
+	"github.com/coopnorge/interview-backend/internal/pkg/printer"
+)
+
+// reportPercentiles of latency shown in report
+var reportPercentiles = []float64{50, 90, 99, 99.9}
+
+// printReport of finished run to STDOUT
+func (s *ServiceInstance) printReport() {
+	for _, o := range s.statistics.Operation {
+		s.reportTable.AddRow(append(
+			[]string{o.Name, strconv.FormatUint(o.A, 10), strconv.FormatUint(o.B, 10)},
+			latencyColumns(&o.Latency)...,
+		))
+	}
+
+	if s.interrupted.Load() {
+		fmt.Fprintln(s.out, "\nRun was interrupted, statistics are partial")
+	}
+	fmt.Fprintln(s.out, "\nExecution time:", time.Since(s.statistics.ExecTime))
+	fmt.Fprintln(s.out, "Retried requests:", s.logisticsClient.Retries())
+	if schedule := s.statistics.Schedule; schedule != nil {
+		fmt.Fprintf(s.out, "Target rate: %.1f requests/s, achieved: %.1f requests/s\n", schedule.TargetRate, schedule.AchievedRate())
+		fmt.Fprintf(s.out, "Missed send deadlines: %d of %d, max lateness: %s\n", schedule.Missed, schedule.Sent, schedule.MaxLateness)
+	}
+	fmt.Fprintln(s.out, s.reportTable)
+
+	if s.statistics.Schedule != nil {
+		correctedTable := printer.NewASCIITablePrinter()
+		correctedTable.AddHeader(append([]string{"Operation"}, latencyHeaders()...))
+		for _, o := range s.statistics.Operation {
+			correctedTable.AddRow(append([]string{o.Name}, latencyColumns(&o.CorrectedLatency)...))
+		}
+		fmt.Fprintln(s.out, "Latency from scheduled send time, corrected for coordinated omission:")
+		fmt.Fprintln(s.out, correctedTable)
+	}
+
+	if len(s.statistics.Stages) > 0 {
+		stageTable := printer.NewASCIITablePrinter()
+		stageTable.AddHeader([]string{"Load Stage", "Requests", "Errors"})
+		for _, stage := range s.statistics.Stages {
+			stageTable.AddRow([]string{stage.Name, strconv.FormatUint(stage.A, 10), strconv.FormatUint(stage.B, 10)})
+		}
+		fmt.Fprintf(s.out, "Load profile (%s):\n", s.load.ProfileTarget)
+		fmt.Fprintln(s.out, stageTable)
+	}
+}
+
+// printVerdicts table of run checks to STDOUT
+func (s *ServiceInstance) printVerdicts(checks []report.Check) {
+	verdictTable := printer.NewASCIITablePrinter()
+	verdictTable.AddHeader([]string{"Check", "Threshold", "Actual", "Verdict"})
+	for _, check := range checks {
+		actual := check.Actual
+		if len(actual) == 0 {
+			actual = check.Message
+		}
+
+		verdict := "FAIL"
+		switch {
+		case check.Skipped:
+			verdict = "SKIP"
+		case check.Passed:
+			verdict = "PASS"
+		}
+
+		verdictTable.AddRow([]string{check.Name, check.Threshold, actual, verdict})
+	}
+
+	fmt.Fprintln(s.out, "Verdict:")
+	fmt.Fprintln(s.out, verdictTable)
+}
+
+// latencyHeaders of columns returned by latencyColumns
+func latencyHeaders() []string {
+	headers := []string{"Min", "Mean"}
+	for _, p := range reportPercentiles {
+		headers = append(headers, "p"+strconv.FormatFloat(p, 'f', -1, 64))
+	}
+
+	return append(headers, "Max")
+}
+
+// latencyColumns of histogram, dashes if nothing was recorded
+func latencyColumns(h *histogram.Histogram) []string {
+	if h.Count() == 0 {
+		columns := make([]string, len(reportPercentiles)+3)
+		for i := range columns {
+			columns[i] = "-"
+		}
+		return columns
+	}
+
+	columns := []string{formatLatency(h.Min()), formatLatency(h.Mean())}
+	for _, p := range reportPercentiles {
+		columns = append(columns, formatLatency(h.Percentile(p)))
+	}
+
+	return append(columns, formatLatency(h.Max()))
+}
+
+// formatLatency rounded to microseconds
+func formatLatency(d time.Duration) string {
+	return d.Round(time.Microsecond).String()
+}
+
+// newReport of finished run with checks
+func (s *ServiceInstance) newReport(checks []report.Check) *report.Report {
+	transport := s.cfg.TransportTypeProtocol
+	if len(transport) == 0 {
+		transport = client.DefaultTransport
+	}
+
+	r := &report.Report{
+		Name:        appName,
+		StartedAt:   s.statistics.ExecTime,
+		DurationMs:  report.Milliseconds(time.Since(s.statistics.ExecTime)),
+		Seed:        s.cfg.Seed,
+		Interrupted: s.interrupted.Load(),
+		Config: report.Config{
+			Transport:        transport,
+			Address:          s.cfg.GetCombinedAddress(),
+			TLS:              s.cfg.TLS.IsEnabled(),
+			LoadRate:         s.cfg.Load.Rate,
+			LoadProfile:      s.cfg.Load.Profile,
+			ReplayPath:       s.cfg.Traffic.ReplayPath,
+			RetryMaxAttempts: s.cfg.Retry.MaxAttempts,
+		},
+		World: report.World{
+			Warehouses: s.warehouses,
+			CargoUnits: s.cargoUnits,
+			Width:      s.cfg.World.Width,
+			Height:     s.cfg.World.Height,
+		},
+		Retries: s.logisticsClient.Retries(),
+		Checks:  checks,
+	}
+	if len(s.cfg.Load.Profile) > 0 {
+		r.Config.LoadTarget = s.cfg.Load.ProfileTarget
+	}
+	if len(s.cfg.Traffic.ReplayPath) > 0 {
+		r.Config.ReplaySpeed = s.cfg.Traffic.ReplaySpeed
+	}
+
+	for _, o := range s.statistics.Operation {
+		operation := report.Operation{
+			Name:    o.Name,
+			Count:   o.A,
+			Errors:  o.B,
+			Latency: report.NewLatency(&o.Latency, reportPercentiles),
+		}
+		if s.statistics.Schedule != nil {
+			corrected := report.NewLatency(&o.CorrectedLatency, reportPercentiles)
+			operation.CorrectedLatency = &corrected
+		}
+		r.Operations = append(r.Operations, operation)
+	}
+
+	if schedule := s.statistics.Schedule; schedule != nil {
+		r.Schedule = &report.Schedule{
+			TargetRate:   schedule.TargetRate,
+			AchievedRate: schedule.AchievedRate(),
+			Sent:         schedule.Sent,
+			Missed:       schedule.Missed,
+			MaxLateness:  report.Milliseconds(schedule.MaxLateness),
+		}
+	}
+
+	for _, stage := range s.statistics.Stages {
+		r.Stages = append(r.Stages, report.Stage{Name: stage.Name, Requests: stage.A, Errors: stage.B})
+	}
+
+	return r
+}
+
+// completionCheck of run, fails if it was interrupted before it finished
+func (s *ServiceInstance) completionCheck() report.Check {
+	if s.interrupted.Load() {
+		return report.Check{Name: "Run completed", Message: "stopped before it finished, statistics are partial"}
+	}
+
+	return report.Check{Name: "Run completed", Passed: true}
+}
+
+// newCheck of run outcome, skipped with skipReason if it is set or if server does not support the check
+func newCheck(name string, checkErr error, skipReason string) report.Check {
+	switch {
+	case len(skipReason) > 0:
+		return report.Check{Name: name, Skipped: true, Message: skipReason}
+	case errors.Is(checkErr, client.ErrNotSupported):
+		return report.Check{Name: name, Skipped: true, Message: checkErr.Error()}
+	case checkErr != nil:
+		return report.Check{Name: name, Message: checkErr.Error()}
+	default:
+		return report.Check{Name: name, Passed: true}
+	}
+}
@@ -0,0 +1,149 @@
+package report
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+	"github.com/coopnorge/interview-backend/internal/pkg/histogram"
+)
+
+// Report of finished run written by WriteJSON, WriteCSV and WriteJUnit
+type Report struct {
+	Name       string    `json:"name"`
+	StartedAt  time.Time `json:"started_at"`
+	DurationMs float64   `json:"duration_ms"`
+	Seed       int64     `json:"seed"`
+	// Interrupted run was stopped before it finished, statistics are partial
+	Interrupted bool        `json:"interrupted"`
+	Config      Config      `json:"config"`
+	World       World       `json:"world"`
+	Retries     uint64      `json:"retries"`
+	Operations  []Operation `json:"operations"`
+	Schedule    *Schedule   `json:"schedule,omitempty"`
+	Stages      []Stage     `json:"stages,omitempty"`
+	Checks      []Check     `json:"checks"`
+}
+
+// Config of client that produced report, without credentials
+type Config struct {
+	Transport        string  `json:"transport"`
+	Address          string  `json:"address"`
+	TLS              bool    `json:"tls"`
+	LoadRate         float64 `json:"load_rate,omitempty"`
+	LoadProfile      string  `json:"load_profile,omitempty"`
+	LoadTarget       string  `json:"load_target,omitempty"`
+	ReplayPath       string  `json:"replay_path,omitempty"`
+	ReplaySpeed      float64 `json:"replay_speed,omitempty"`
+	RetryMaxAttempts int     `json:"retry_max_attempts"`
+}
+
+// World that was simulated, counts are zero when recorded traffic was replayed
+type World struct {
+	Warehouses uint32 `json:"warehouses"`
+	CargoUnits uint32 `json:"cargo_units"`
+	Width      uint32 `json:"width"`
+	Height     uint32 `json:"height"`
+}
+
+// Operation statistics like MoveUnit
+type Operation struct {
+	Name    string  `json:"name"`
+	Count   uint64  `json:"count"`
+	Errors  uint64  `json:"errors"`
+	Latency Latency `json:"latency"`
+	// CorrectedLatency from scheduled send time, only in open loop
+	CorrectedLatency *Latency `json:"corrected_latency,omitempty"`
+}
+
+// Latency summary in milliseconds
+type Latency struct {
+	Count  uint64  `json:"count"`
+	MinMs  float64 `json:"min_ms"`
+	MeanMs float64 `json:"mean_ms"`
+	// PercentilesMs by name like p99.9
+	PercentilesMs map[string]float64 `json:"percentiles_ms"`
+	MaxMs         float64            `json:"max_ms"`
+}
+
+// Schedule of open loop run
+type Schedule struct {
+	TargetRate   float64 `json:"target_rate"`
+	AchievedRate float64 `json:"achieved_rate"`
+	Sent         uint64  `json:"sent"`
+	Missed       uint64  `json:"missed_deadlines"`
+	MaxLateness  float64 `json:"max_lateness_ms"`
+}
+
+// Stage of load profile
+type Stage struct {
+	Name     string `json:"name"`
+	Requests uint64 `json:"requests"`
+	Errors   uint64 `json:"errors"`
+}
+
+// Check of run outcome like reconciliation with server or SLO, becomes test case of JUnit report
+type Check struct {
+	Name    string `json:"name"`
+	Passed  bool   `json:"passed"`
+	Skipped bool   `json:"skipped,omitempty"`
+	Message string `json:"message,omitempty"`
+	// Threshold and Actual value of SLO
+	Threshold string `json:"threshold,omitempty"`
+	Actual    string `json:"actual,omitempty"`
+}
+
+// NewLatency summary of histogram with given percentiles
+func NewLatency(h *histogram.Histogram, percentiles []float64) Latency {
+	latency := Latency{
+		Count:         h.Count(),
+		MinMs:         Milliseconds(h.Min()),
+		MeanMs:        Milliseconds(h.Mean()),
+		PercentilesMs: make(map[string]float64, len(percentiles)),
+		MaxMs:         Milliseconds(h.Max()),
+	}
+	for _, p := range percentiles {
+		latency.PercentilesMs[PercentileName(p)] = Milliseconds(h.Percentile(p))
+	}
+
+	return latency
+}
+
+// PercentileName like p99.9
+func PercentileName(percentile float64) string {
+	return "p" + strconv.FormatFloat(percentile, 'f', -1, 64)
+}
+
+// Milliseconds of duration with fraction
+func Milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// Failed checks of report
+func (r *Report) Failed() []Check {
+	var failed []Check
+	for _, c := range r.Checks {
+		if !c.Passed && !c.Skipped {
+			failed = append(failed, c)
+		}
+	}
+
+	return failed
+}
+
+// WriteFiles of every format that has path in cfg
+func (r *Report) WriteFiles(cfg config.ClientReportConfig) error {
+	var writeErrs []error
+	if len(cfg.JSONPath) > 0 {
+		writeErrs = append(writeErrs, WriteJSON(cfg.JSONPath, r))
+	}
+	if len(cfg.CSVPath) > 0 {
+		writeErrs = append(writeErrs, WriteCSV(cfg.CSVPath, r))
+	}
+	if len(cfg.JUnitPath) > 0 {
+		writeErrs = append(writeErrs, WriteJUnit(cfg.JUnitPath, r))
+	}
+
+	return errors.Join(writeErrs...)
+}
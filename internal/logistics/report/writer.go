@@ -0,0 +1,218 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+)
+
+// WriteJSON report to path
+func WriteJSON(path string, r *Report) error {
+	return writeFile(path, func(w io.Writer) error {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(r)
+	})
+}
+
+// WriteCSV report to path, one value per row with columns section, name, metric and value
+func WriteCSV(path string, r *Report) error {
+	return writeFile(path, func(w io.Writer) error {
+		writer := csv.NewWriter(w)
+		for _, row := range csvRows(r) {
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+
+		return writer.Error()
+	})
+}
+
+func csvRows(r *Report) [][]string {
+	rows := [][]string{{"section", "name", "metric", "value"}}
+	add := func(section, name, metric, value string) {
+		rows = append(rows, []string{section, name, metric, value})
+	}
+	addFloat := func(section, name, metric string, value float64) {
+		add(section, name, metric, strconv.FormatFloat(value, 'f', -1, 64))
+	}
+	addUint := func(section, name, metric string, value uint64) {
+		add(section, name, metric, strconv.FormatUint(value, 10))
+	}
+	addLatency := func(name, prefix string, latency Latency) {
+		addFloat("operation", name, prefix+"min_ms", latency.MinMs)
+		addFloat("operation", name, prefix+"mean_ms", latency.MeanMs)
+		for _, percentile := range sortedKeys(latency.PercentilesMs) {
+			addFloat("operation", name, prefix+percentile+"_ms", latency.PercentilesMs[percentile])
+		}
+		addFloat("operation", name, prefix+"max_ms", latency.MaxMs)
+	}
+
+	add("run", r.Name, "started_at", r.StartedAt.Format("2006-01-02T15:04:05.000Z07:00"))
+	addFloat("run", r.Name, "duration_ms", r.DurationMs)
+	add("run", r.Name, "seed", strconv.FormatInt(r.Seed, 10))
+	addUint("run", r.Name, "retries", r.Retries)
+	add("run", r.Name, "interrupted", strconv.FormatBool(r.Interrupted))
+
+	add("config", "", "transport", r.Config.Transport)
+	add("config", "", "address", r.Config.Address)
+	add("config", "", "tls", strconv.FormatBool(r.Config.TLS))
+	addFloat("config", "", "load_rate", r.Config.LoadRate)
+	add("config", "", "load_profile", r.Config.LoadProfile)
+	add("config", "", "load_target", r.Config.LoadTarget)
+	add("config", "", "replay_path", r.Config.ReplayPath)
+	addFloat("config", "", "replay_speed", r.Config.ReplaySpeed)
+	add("config", "", "retry_max_attempts", strconv.Itoa(r.Config.RetryMaxAttempts))
+
+	addUint("world", "", "warehouses", uint64(r.World.Warehouses))
+	addUint("world", "", "cargo_units", uint64(r.World.CargoUnits))
+	addUint("world", "", "width", uint64(r.World.Width))
+	addUint("world", "", "height", uint64(r.World.Height))
+
+	for _, o := range r.Operations {
+		addUint("operation", o.Name, "count", o.Count)
+		addUint("operation", o.Name, "errors", o.Errors)
+		addLatency(o.Name, "latency_", o.Latency)
+		if o.CorrectedLatency != nil {
+			addLatency(o.Name, "corrected_latency_", *o.CorrectedLatency)
+		}
+	}
+
+	if r.Schedule != nil {
+		addFloat("schedule", "", "target_rate", r.Schedule.TargetRate)
+		addFloat("schedule", "", "achieved_rate", r.Schedule.AchievedRate)
+		addUint("schedule", "", "sent", r.Schedule.Sent)
+		addUint("schedule", "", "missed_deadlines", r.Schedule.Missed)
+		addFloat("schedule", "", "max_lateness_ms", r.Schedule.MaxLateness)
+	}
+
+	for _, stage := range r.Stages {
+		addUint("stage", stage.Name, "requests", stage.Requests)
+		addUint("stage", stage.Name, "errors", stage.Errors)
+	}
+
+	for _, c := range r.Checks {
+		add("check", c.Name, "passed", strconv.FormatBool(c.Passed))
+		add("check", c.Name, "skipped", strconv.FormatBool(c.Skipped))
+		add("check", c.Name, "message", c.Message)
+		if len(c.Threshold) > 0 {
+			add("check", c.Name, "threshold", c.Threshold)
+			add("check", c.Name, "actual", c.Actual)
+		}
+	}
+
+	return rows
+}
+
+// junitTestSuites root of JUnit XML report
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Time       string          `xml:"time,attr"`
+	Timestamp  string          `xml:"timestamp,attr"`
+	Properties []junitProperty `xml:"properties>property"`
+	TestCases  []junitTestCase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+}
+
+// WriteJUnit report to path, every check is test case
+func WriteJUnit(path string, r *Report) error {
+	suite := junitTestSuite{
+		Name:      r.Name,
+		Tests:     len(r.Checks),
+		Time:      strconv.FormatFloat(r.DurationMs/1000, 'f', 3, 64),
+		Timestamp: r.StartedAt.Format("2006-01-02T15:04:05"),
+		Properties: []junitProperty{
+			{Name: "seed", Value: strconv.FormatInt(r.Seed, 10)},
+			{Name: "transport", Value: r.Config.Transport},
+			{Name: "address", Value: r.Config.Address},
+		},
+	}
+
+	for _, c := range r.Checks {
+		testCase := junitTestCase{Name: c.Name, ClassName: r.Name, Time: "0"}
+		switch {
+		case c.Skipped:
+			suite.Skipped++
+			testCase.Skipped = &junitMessage{Message: c.Message}
+		case !c.Passed:
+			suite.Failures++
+			testCase.Failure = &junitMessage{Message: c.Message}
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+
+	return writeFile(path, func(w io.Writer) error {
+		if _, err := io.WriteString(w, xml.Header); err != nil {
+			return err
+		}
+
+		encoder := xml.NewEncoder(w)
+		encoder.Indent("", "  ")
+		if err := encoder.Encode(junitTestSuites{Suites: []junitTestSuite{suite}}); err != nil {
+			return err
+		}
+
+		_, err := io.WriteString(w, "\n")
+		return err
+	})
+}
+
+// writeFile at path with content from write, file is created or truncated
+func writeFile(path string, write func(w io.Writer) error) error {
+	file, createErr := os.Create(path)
+	if createErr != nil {
+		return fmt.Errorf("failed to create report file, error: %w", createErr)
+	}
+
+	writeErr := write(file)
+	closeErr := file.Close()
+	if writeErr != nil {
+		return fmt.Errorf("failed to write report %s, error: %w", path, writeErr)
+	}
+	if closeErr != nil {
+		return fmt.Errorf("failed to write report %s, error: %w", path, closeErr)
+	}
+
+	return nil
+}
+
+func sortedKeys(values map[string]float64) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
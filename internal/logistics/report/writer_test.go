@@ -0,0 +1,98 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+	"github.com/coopnorge/interview-backend/internal/pkg/histogram"
+)
+
+func newTestReport() *Report {
+	var latency histogram.Histogram
+	latency.Record(time.Millisecond)
+	latency.Record(3 * time.Millisecond)
+
+	return &Report{
+		Name:        "Test",
+		StartedAt:   time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+		DurationMs:  1500,
+		Seed:        42,
+		Interrupted: true,
+		Config:      Config{Transport: "gRPC", Address: "127.0.0.1:50051", RetryMaxAttempts: 3},
+		World:       World{Warehouses: 2, CargoUnits: 10, Width: 255, Height: 255},
+		Operations: []Operation{
+			{Name: "MoveUnit", Count: 100, Errors: 1, Latency: NewLatency(&latency, []float64{50, 99})},
+		},
+		Stages: []Stage{{Name: "hold:10/1s", Requests: 100, Errors: 1}},
+		Checks: []Check{
+			{Name: "Passing", Passed: true},
+			{Name: "Failing", Message: "too slow"},
+			{Name: "Unsupported", Skipped: true, Message: "not supported"},
+		},
+	}
+}
+
+func TestWriteFiles(t *testing.T) {
+	dir := t.TempDir()
+	cfg := config.ClientReportConfig{
+		JSONPath:  filepath.Join(dir, "report.json"),
+		CSVPath:   filepath.Join(dir, "report.csv"),
+		JUnitPath: filepath.Join(dir, "report.xml"),
+	}
+
+	if writeErr := newTestReport().WriteFiles(cfg); writeErr != nil {
+		t.Fatalf("Not expected error from WriteFiles, error: %v", writeErr)
+	}
+
+	jsonFile, _ := os.ReadFile(cfg.JSONPath)
+	var decoded Report
+	if err := json.Unmarshal(jsonFile, &decoded); err != nil {
+		t.Fatalf("Expected valid JSON report, error: %v", err)
+	}
+	if decoded.Seed != 42 || !decoded.Interrupted || len(decoded.Operations) != 1 || decoded.Operations[0].Latency.PercentilesMs["p99"] < 3 {
+		t.Errorf("Expected JSON report to contain seed and latency, but got %+v", decoded)
+	}
+
+	csvFile, _ := os.Open(cfg.CSVPath)
+	defer csvFile.Close()
+	rows, csvErr := csv.NewReader(csvFile).ReadAll()
+	if csvErr != nil {
+		t.Fatalf("Expected valid CSV report, error: %v", csvErr)
+	}
+	found := false
+	for _, row := range rows {
+		if len(row) != 4 {
+			t.Fatalf("Expected 4 columns in every CSV row, but got %v", row)
+		}
+		found = found || (row[0] == "operation" && row[1] == "MoveUnit" && row[2] == "latency_p50_ms")
+	}
+	if !found {
+		t.Errorf("Expected CSV report to contain MoveUnit p50 latency")
+	}
+
+	xmlFile, _ := os.ReadFile(cfg.JUnitPath)
+	var suites junitTestSuites
+	if err := xml.Unmarshal(xmlFile, &suites); err != nil {
+		t.Fatalf("Expected valid JUnit report, error: %v", err)
+	}
+	suite := suites.Suites[0]
+	if suite.Tests != 3 || suite.Failures != 1 || suite.Skipped != 1 {
+		t.Errorf("Expected 3 tests with 1 failure and 1 skipped, but got %d, %d and %d", suite.Tests, suite.Failures, suite.Skipped)
+	}
+	if suite.TestCases[1].Failure == nil || suite.TestCases[1].Failure.Message != "too slow" {
+		t.Errorf("Expected failing check to have failure message, but got %+v", suite.TestCases[1])
+	}
+}
+
+func TestReportFailed(t *testing.T) {
+	failed := newTestReport().Failed()
+	if len(failed) != 1 || failed[0].Name != "Failing" {
+		t.Errorf("Expected only Failing check to fail, but got %+v", failed)
+	}
+}
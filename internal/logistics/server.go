@@ -0,0 +1,144 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/server"
+	"google.golang.org/grpc"
+)
+
+const (
+	serverAppName = "Coop Logistics Engine Server"
+
+	serverShutdownTimeout = 10 * time.Second
+)
+
+// ServerInstance of reference API server application
+type ServerInstance struct {
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+
+	cfg             *config.ServerAppConfig
+	logisticsServer *server.APILogisticsServer
+	grpcServer      *grpc.Server
+	httpServer      *http.Server
+}
+
+// NewServerInstance constructor
+func NewServerInstance(
+	ls *server.APILogisticsServer,
+	authenticator *server.Authenticator,
+	cfg *config.ServerAppConfig,
+) (*ServerInstance, error) {
+	log.Printf("%s, initializing...\n", serverAppName)
+
+	serviceCtx, serviceCtxCancel := context.WithCancel(context.Background())
+
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(authenticator.UnaryInterceptor()),
+		grpc.StreamInterceptor(authenticator.StreamInterceptor()),
+	)
+	apiv1.RegisterCoopLogisticsEngineAPIServer(grpcServer, ls)
+
+	gatewayMux := server.NewGatewayMux()
+	if registerErr := apiv1.RegisterCoopLogisticsEngineAPIHandlerServer(serviceCtx, gatewayMux, ls); registerErr != nil {
+		serviceCtxCancel()
+		return nil, fmt.Errorf("%s, failed to register HTTP gateway, error: %w", serverAppName, registerErr)
+	}
+
+	movementEvents := ls.MovementEventsHandler()
+	handlePathErr := gatewayMux.HandlePath(http.MethodGet, "/v1/cargo_unit/movements", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		movementEvents.ServeHTTP(w, r)
+	})
+	if handlePathErr != nil {
+		serviceCtxCancel()
+		return nil, fmt.Errorf("%s, failed to register movement events, error: %w", serverAppName, handlePathErr)
+	}
+
+	return &ServerInstance{
+		ctx:       serviceCtx,
+		ctxCancel: serviceCtxCancel,
+
+		cfg:             cfg,
+		logisticsServer: ls,
+		grpcServer:      grpcServer,
+		httpServer: &http.Server{
+			Addr:              cfg.GetHTTPAddress(),
+			Handler:           authenticator.HTTPHandler(gatewayMux),
+			ReadHeaderTimeout: 5 * time.Second,
+		},
+	}, nil
+}
+
+// Run server until stop signal or serving error
+func (s *ServerInstance) Run() error {
+	grpcListener, listenErr := net.Listen("tcp", s.cfg.GetGRPCAddress())
+	if listenErr != nil {
+		return fmt.Errorf("%s, failed to listen on %s, error: %w", serverAppName, s.cfg.GetGRPCAddress(), listenErr)
+	}
+
+	serveErrors := make(chan error, 2)
+	go func() {
+		log.Printf("%s, serving gRPC on %s\n", serverAppName, s.cfg.GetGRPCAddress())
+		serveErrors <- s.grpcServer.Serve(grpcListener)
+	}()
+	go func() {
+		log.Printf("%s, serving HTTP on %s\n", serverAppName, s.cfg.GetHTTPAddress())
+		if serveErr := s.httpServer.ListenAndServe(); !errors.Is(serveErr, http.ErrServerClosed) {
+			serveErrors <- serveErr
+		}
+	}()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			log.Printf("%s, received %d messages in last second\n", serverAppName, s.logisticsServer.SwapReceived())
+		case serveErr := <-serveErrors:
+			s.shutdown()
+			return fmt.Errorf("%s, stopped serving, error: %w", serverAppName, serveErr)
+		case <-signals:
+			s.shutdown()
+			return nil
+		}
+	}
+}
+
+func (s *ServerInstance) shutdown() {
+	log.Printf("%s, shutting down...\n", serverAppName)
+
+	s.ctxCancel()
+	s.logisticsServer.CloseSubscriptions()
+
+	shutdownCtx, shutdownCtxCancel := context.WithTimeout(context.Background(), serverShutdownTimeout)
+	defer shutdownCtxCancel()
+
+	_ = s.httpServer.Shutdown(shutdownCtx)
+	s.grpcServer.GracefulStop()
+
+	outOfOrder, duplicates := s.logisticsServer.SequenceAnomalies()
+	log.Printf(
+		"%s, stopped! Total messages received: %d, out of order: %d, duplicates: %d\n",
+		serverAppName,
+		s.logisticsServer.TotalReceived(),
+		outOfOrder,
+		duplicates,
+	)
+}
@@ -0,0 +1,50 @@
+package apitest
+
+import (
+	"context"
+	"net"
+	"net/http/httptest"
+	"testing"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/server"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// bufconnSize of in-memory connection buffer
+const bufconnSize = 1 << 20
+
+// ServeGRPC api over in-memory listener until test ends, returned dialer connects to it from any address
+func ServeGRPC(t testing.TB, api apiv1.CoopLogisticsEngineAPIServer) func(ctx context.Context, addr string) (net.Conn, error) {
+	t.Helper()
+
+	listener := bufconn.Listen(bufconnSize)
+	grpcServer := grpc.NewServer()
+	apiv1.RegisterCoopLogisticsEngineAPIServer(grpcServer, api)
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+
+	return func(ctx context.Context, _ string) (net.Conn, error) {
+		return listener.DialContext(ctx)
+	}
+}
+
+// ServeHTTP api through HTTP gateway on local test server until test ends, returns host:port of the server.
+// Requests are accepted as query parameters, protojson or binary protobuf bodies like reference server does.
+func ServeHTTP(t testing.TB, api apiv1.CoopLogisticsEngineAPIServer) string {
+	t.Helper()
+
+	mux := server.NewGatewayMux()
+	if registerErr := apiv1.RegisterCoopLogisticsEngineAPIHandlerServer(context.Background(), mux, api); registerErr != nil {
+		t.Fatalf("Not expected error from RegisterCoopLogisticsEngineAPIHandlerServer, error: %v", registerErr)
+	}
+
+	httpServer := httptest.NewServer(mux)
+	t.Cleanup(func() {
+		httpServer.CloseClientConnections()
+		httpServer.Close()
+	})
+
+	return httpServer.Listener.Addr().String()
+}
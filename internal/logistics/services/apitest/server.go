@@ -0,0 +1,244 @@
+// Package apitest provides in-process fake API server with programmable responses for tests of logistics client.
+package apitest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+	"google.golang.org/grpc/status"
+)
+
+// Methods of API that Behavior can be set for
+const (
+	MethodMoveUnit             = "MoveUnit"
+	MethodUnitReachedWarehouse = "UnitReachedWarehouse"
+	// MethodMoveUnits behavior applies to every message of the stream, failed messages are rejected
+	MethodMoveUnits         = "MoveUnits"
+	MethodGetReceivedCounts = "GetReceivedCounts"
+)
+
+// Response of fake server to single call
+type Response struct {
+	// Latency before server responds
+	Latency time.Duration
+	// Err returned instead of response, like status.Error(codes.Unavailable, "down")
+	Err error
+	// Drop call, server does not respond until client cancels it
+	Drop bool
+}
+
+// Behavior returns Response to call of method, calls are numbered from 1
+type Behavior func(call uint64) Response
+
+// Respond to every call with response
+func Respond(response Response) Behavior {
+	return func(uint64) Response { return response }
+}
+
+// FailFirst n calls with err, next calls succeed
+func FailFirst(n uint64, err error) Behavior {
+	return func(call uint64) Response {
+		if call <= n {
+			return Response{Err: err}
+		}
+		return Response{}
+	}
+}
+
+// FailEvery n-th call with err
+func FailEvery(n uint64, err error) Behavior {
+	return func(call uint64) Response {
+		if call%n == 0 {
+			return Response{Err: err}
+		}
+		return Response{}
+	}
+}
+
+// Server is fake CoopLogisticsEngineAPIServer that responds as programmed and records accepted requests,
+// methods without behavior accept every call right away
+type Server struct {
+	apiv1.UnimplementedCoopLogisticsEngineAPIServer
+
+	behaviors map[string]Behavior
+	calls     map[string]uint64
+	moves     []*apiv1.MoveUnitRequest
+	reached   []*apiv1.UnitReachedWarehouseRequest
+
+	// inFlight calls being responded and maxInFlight of them at any time
+	inFlight, maxInFlight int
+
+	sync.Mutex
+}
+
+// NewServer that accepts every call until behavior is set
+func NewServer() *Server {
+	return &Server{
+		behaviors: make(map[string]Behavior),
+		calls:     make(map[string]uint64),
+	}
+}
+
+// SetBehavior of method, nil behavior accepts every call
+func (s *Server) SetBehavior(method string, behavior Behavior) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.behaviors[method] = behavior
+}
+
+// Calls of method received so far, including failed and dropped ones
+func (s *Server) Calls(method string) uint64 {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.calls[method]
+}
+
+// MaxInFlight calls that server was responding to at the same time
+func (s *Server) MaxInFlight() int {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.maxInFlight
+}
+
+// Moves accepted by MoveUnit and MoveUnits in order they were received
+func (s *Server) Moves() []*apiv1.MoveUnitRequest {
+	s.Lock()
+	defer s.Unlock()
+
+	return append([]*apiv1.MoveUnitRequest(nil), s.moves...)
+}
+
+// Reached announcements accepted by UnitReachedWarehouse in order they were received
+func (s *Server) Reached() []*apiv1.UnitReachedWarehouseRequest {
+	s.Lock()
+	defer s.Unlock()
+
+	return append([]*apiv1.UnitReachedWarehouseRequest(nil), s.reached...)
+}
+
+// MoveUnit accepted unless behavior fails it
+func (s *Server) MoveUnit(ctx context.Context, req *apiv1.MoveUnitRequest) (*apiv1.DefaultResponse, error) {
+	if respondErr := s.respond(ctx, MethodMoveUnit); respondErr != nil {
+		return nil, respondErr
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.moves = append(s.moves, req)
+
+	return &apiv1.DefaultResponse{}, nil
+}
+
+// UnitReachedWarehouse accepted unless behavior fails it
+func (s *Server) UnitReachedWarehouse(ctx context.Context, req *apiv1.UnitReachedWarehouseRequest) (*apiv1.DefaultResponse, error) {
+	if respondErr := s.respond(ctx, MethodUnitReachedWarehouse); respondErr != nil {
+		return nil, respondErr
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.reached = append(s.reached, req)
+
+	return &apiv1.DefaultResponse{}, nil
+}
+
+// MoveUnits accepts every message of the stream unless behavior fails it, failed messages are counted as rejected
+func (s *Server) MoveUnits(stream apiv1.CoopLogisticsEngineAPI_MoveUnitsServer) error {
+	var accepted, rejected uint64
+	for {
+		req, recvErr := stream.Recv()
+		if errors.Is(recvErr, io.EOF) {
+			return stream.SendAndClose(&apiv1.MoveUnitsResponse{AcceptedCount: accepted, RejectedCount: rejected})
+		}
+		if recvErr != nil {
+			return recvErr
+		}
+
+		if respondErr := s.respond(stream.Context(), MethodMoveUnits); respondErr != nil {
+			rejected++
+			continue
+		}
+
+		s.Lock()
+		s.moves = append(s.moves, req)
+		s.Unlock()
+		accepted++
+	}
+}
+
+// GetReceivedCounts of accepted requests per cargo unit of requested run unless behavior fails it
+func (s *Server) GetReceivedCounts(ctx context.Context, req *apiv1.GetReceivedCountsRequest) (*apiv1.GetReceivedCountsResponse, error) {
+	if respondErr := s.respond(ctx, MethodGetReceivedCounts); respondErr != nil {
+		return nil, respondErr
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	counts := make(map[int64]*apiv1.CargoUnitReceivedCounts)
+	unitCounts := func(cargoUnitID int64) *apiv1.CargoUnitReceivedCounts {
+		if _, ok := counts[cargoUnitID]; !ok {
+			counts[cargoUnitID] = &apiv1.CargoUnitReceivedCounts{CargoUnitId: cargoUnitID}
+		}
+		return counts[cargoUnitID]
+	}
+	for _, move := range s.moves {
+		if move.GetRunId() == req.GetRunId() {
+			unitCounts(move.GetCargoUnitId()).MoveUnitCount++
+		}
+	}
+	for _, reached := range s.reached {
+		if reached.GetRunId() == req.GetRunId() {
+			unitCounts(reached.GetAnnouncement().GetCargoUnitId()).UnitReachedWarehouseCount++
+		}
+	}
+
+	resp := &apiv1.GetReceivedCountsResponse{}
+	for _, unit := range counts {
+		resp.CargoUnits = append(resp.CargoUnits, unit)
+	}
+
+	return resp, nil
+}
+
+// respond to next call of method as its behavior says, waits for latency or until ctx is done if call is dropped
+func (s *Server) respond(ctx context.Context, method string) error {
+	s.Lock()
+	s.calls[method]++
+	call, behavior := s.calls[method], s.behaviors[method]
+	s.inFlight++
+	s.maxInFlight = max(s.maxInFlight, s.inFlight)
+	s.Unlock()
+
+	defer func() {
+		s.Lock()
+		defer s.Unlock()
+		s.inFlight--
+	}()
+
+	if behavior == nil {
+		return nil
+	}
+	response := behavior(call)
+
+	if response.Drop {
+		<-ctx.Done()
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	if response.Latency > 0 {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-time.After(response.Latency):
+		}
+	}
+
+	return response.Err
+}
@@ -0,0 +1,158 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+)
+
+// tokenFileCheckInterval how often token file is checked for changes
+const tokenFileCheckInterval = time.Second
+
+// Credentials attached to every gRPC and HTTP request, implements credentials.PerRPCCredentials
+type Credentials struct {
+	token     string
+	tokenFile *tokenFile
+
+	apiKey       string
+	apiKeyHeader string
+}
+
+// tokenFile re-read when its modification time or size changes
+type tokenFile struct {
+	path          string
+	checkInterval time.Duration
+
+	token   string
+	modTime time.Time
+	size    int64
+	checked time.Time
+
+	sync.Mutex
+}
+
+// NewCredentials from configuration, nil if no credentials configured
+func NewCredentials(cfg config.ClientAuthConfig) (*Credentials, error) {
+	if len(cfg.Token) == 0 && len(cfg.TokenFile) == 0 && len(cfg.APIKey) == 0 {
+		return nil, nil
+	}
+
+	c := &Credentials{token: cfg.Token, apiKey: cfg.APIKey, apiKeyHeader: cfg.APIKeyHeader}
+	if len(c.apiKeyHeader) == 0 {
+		c.apiKeyHeader = config.DefaultAPIKeyHeader
+	}
+
+	if len(cfg.TokenFile) > 0 {
+		c.tokenFile = &tokenFile{path: cfg.TokenFile, checkInterval: tokenFileCheckInterval}
+		if _, readErr := c.tokenFile.get(); readErr != nil {
+			return nil, readErr
+		}
+	}
+
+	return c, nil
+}
+
+// Headers with credentials by canonical HTTP header name
+func (c *Credentials) Headers() (map[string]string, error) {
+	headers := make(map[string]string, 2)
+
+	token := c.token
+	if c.tokenFile != nil {
+		var readErr error
+		if token, readErr = c.tokenFile.get(); readErr != nil {
+			return nil, readErr
+		}
+	}
+	if len(token) > 0 {
+		headers["Authorization"] = "Bearer " + token
+	}
+	if len(c.apiKey) > 0 {
+		headers[http.CanonicalHeaderKey(c.apiKeyHeader)] = c.apiKey
+	}
+
+	return headers, nil
+}
+
+// GetRequestMetadata of gRPC call, metadata keys are lower case
+func (c *Credentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	headers, headersErr := c.Headers()
+	if headersErr != nil {
+		return nil, headersErr
+	}
+
+	md := make(map[string]string, len(headers))
+	for k, v := range headers {
+		md[strings.ToLower(k)] = v
+	}
+
+	return md, nil
+}
+
+// RequireTransportSecurity is false, so credentials can be used with test servers without TLS
+func (c *Credentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// RoundTripper that adds credentials to HTTP requests sent by next
+func (c *Credentials) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	return &credentialsRoundTripper{credentials: c, next: next}
+}
+
+type credentialsRoundTripper struct {
+	credentials *Credentials
+	next        http.RoundTripper
+}
+
+// RoundTrip request with credentials headers, original request is not modified
+func (rt *credentialsRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	headers, headersErr := rt.credentials.Headers()
+	if headersErr != nil {
+		return nil, headersErr
+	}
+
+	req = req.Clone(req.Context())
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	return rt.next.RoundTrip(req)
+}
+
+// get token, file is checked for changes at most once per checkInterval
+func (f *tokenFile) get() (string, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	if len(f.token) > 0 && time.Since(f.checked) < f.checkInterval {
+		return f.token, nil
+	}
+
+	info, statErr := os.Stat(f.path)
+	if statErr != nil {
+		return "", fmt.Errorf("failed to check auth token file, error: %w", statErr)
+	}
+	f.checked = time.Now()
+	if len(f.token) > 0 && info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return f.token, nil
+	}
+
+	content, readErr := os.ReadFile(f.path)
+	if readErr != nil {
+		return "", fmt.Errorf("failed to read auth token file, error: %w", readErr)
+	}
+	token := strings.TrimSpace(string(content))
+	if len(token) == 0 {
+		return "", errors.New("auth token file is empty")
+	}
+
+	f.token, f.modTime, f.size = token, info.ModTime(), info.Size()
+
+	return f.token, nil
+}
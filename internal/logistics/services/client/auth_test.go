@@ -0,0 +1,126 @@
+package client
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/server"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/store"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCredentialsRereadTokenFile(t *testing.T) {
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenPath, []byte("first\n"), 0o600); err != nil {
+		t.Fatalf("Not expected error writing token, error: %v", err)
+	}
+
+	c, credentialsErr := NewCredentials(config.ClientAuthConfig{TokenFile: tokenPath, APIKey: "key", APIKeyHeader: "x-api-key"})
+	if credentialsErr != nil {
+		t.Fatalf("Not expected error from NewCredentials, error: %v", credentialsErr)
+	}
+	c.tokenFile.checkInterval = 0
+
+	md, _ := c.GetRequestMetadata(context.Background())
+	if md["authorization"] != "Bearer first" || md["x-api-key"] != "key" {
+		t.Errorf("Expected token and API key in metadata, but got %v", md)
+	}
+
+	if err := os.WriteFile(tokenPath, []byte("second-token"), 0o600); err != nil {
+		t.Fatalf("Not expected error writing token, error: %v", err)
+	}
+	headers, _ := c.Headers()
+	if headers["Authorization"] != "Bearer second-token" || headers["X-Api-Key"] != "key" {
+		t.Errorf("Expected changed token to be re-read, but got %v", headers)
+	}
+
+	if _, err := NewCredentials(config.ClientAuthConfig{TokenFile: filepath.Join(t.TempDir(), "missing")}); err == nil {
+		t.Errorf("Expected error for missing token file")
+	}
+}
+
+func TestAPILogisticsClientAuthenticatesOverGRPC(t *testing.T) {
+	authenticator := server.NewAuthenticator(&config.ServerAppConfig{AuthToken: "secret"})
+
+	listener, listenErr := net.Listen("tcp", "127.0.0.1:0")
+	if listenErr != nil {
+		t.Fatalf("Not expected error from Listen, error: %v", listenErr)
+	}
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(authenticator.UnaryInterceptor()),
+		grpc.StreamInterceptor(authenticator.StreamInterceptor()),
+	)
+	apiv1.RegisterCoopLogisticsEngineAPIServer(grpcServer, server.NewLogisticsServer(
+		store.NewMemoryStore(),
+		server.NewMovementBroker(),
+		server.NewUnitCounter(),
+		server.NewIdempotencyCache(),
+	))
+	go func() { _ = grpcServer.Serve(listener) }()
+	defer grpcServer.Stop()
+
+	for _, token := range []string{"secret", "guess"} {
+		lc, clientErr := NewLogisticsClient(&config.ClientAppConfig{
+			TransportTypeProtocol: TransportTypeGRPCStr,
+			Retry:                 config.ClientRetryConfig{MaxAttempts: 1},
+			Auth:                  config.ClientAuthConfig{Token: token},
+		})
+		if clientErr != nil {
+			t.Fatalf("Not expected error from NewLogisticsClient, error: %v", clientErr)
+		}
+
+		ctx, ctxCancel := context.WithTimeout(context.Background(), 2*time.Second)
+		if err := lc.Connect(listener.Addr().String(), ctx); err != nil {
+			t.Fatalf("Not expected error from Connect, error: %v", err)
+		}
+
+		err := lc.MoveUnit(ctx, &apiv1.MoveUnitRequest{CargoUnitId: 1, Location: &apiv1.Location{}})
+		if token == "secret" && err != nil {
+			t.Errorf("Not expected error with valid token, error: %v", err)
+		} else if token != "secret" && status.Code(err) != codes.Unauthenticated {
+			t.Errorf("Expected %s with invalid token, but got %v", codes.Unauthenticated, err)
+		}
+
+		_ = lc.Disconnect()
+		ctxCancel()
+	}
+}
+
+func TestAPILogisticsClientAuthenticatesOverHTTP(t *testing.T) {
+	authenticator := server.NewAuthenticator(&config.ServerAppConfig{AuthAPIKey: "key"})
+	httpServer := httptest.NewServer(authenticator.HTTPHandler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("{}"))
+	})))
+	defer httpServer.Close()
+
+	for _, apiKey := range []string{"key", ""} {
+		lc, clientErr := NewLogisticsClient(&config.ClientAppConfig{
+			TransportTypeProtocol: TransportTypeHTTPStr,
+			Scheme:                "http",
+			Retry:                 config.ClientRetryConfig{MaxAttempts: 1},
+			Auth:                  config.ClientAuthConfig{APIKey: apiKey},
+		})
+		if clientErr != nil {
+			t.Fatalf("Not expected error from NewLogisticsClient, error: %v", clientErr)
+		}
+		_ = lc.Connect(httpServer.Listener.Addr().String(), context.Background())
+
+		err := lc.MoveUnit(context.Background(), &apiv1.MoveUnitRequest{CargoUnitId: 1, Location: &apiv1.Location{}})
+		if len(apiKey) > 0 && err != nil {
+			t.Errorf("Not expected error with API key, error: %v", err)
+		} else if len(apiKey) == 0 && err == nil {
+			t.Errorf("Expected anonymous request to be rejected")
+		}
+	}
+}
@@ -2,131 +2,207 @@ package client
 
 import (
 	"context"
-	"strconv"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
 
 	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
-	"github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1/openapi"
 	"github.com/coopnorge/interview-backend/internal/logistics/config"
 	"github.com/google/wire"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // ServiceSetForClient providers
 var ServiceSetForClient = wire.NewSet(NewLogisticsClient)
 
-// TransportType represents how messages must be sent.
-type TransportType byte
+// ErrNotSupported returned when server does not implement optional API method.
+var ErrNotSupported = errors.New("not supported by server")
 
-const (
-	// TransportTypeGRPC default mode how client will send messages to server.
-	TransportTypeGRPC TransportType = iota
-	// TransportTypeHTTP generic HTTP requests.
-	TransportTypeHTTP
-)
-
-const (
-	TransportTypeGRPCStr = "gRPC"
-	TransportTypeHTTPStr = "HTTP"
-)
+// ErrNotConnected returned by transport that is used before Connect or after Connect failed.
+var ErrNotConnected = errors.New("transport is not connected")
 
-// APILogisticsClient to send requests about cargo unit movements
+// APILogisticsClient to send requests about cargo unit movements over configured Transport
 type APILogisticsClient struct {
-	apiClientGRPC apiv1.CoopLogisticsEngineAPIClient
-	apiClientHTTP *openapi.APIClient
+	transport     Transport
+	transportName string
+	recorder      *TrafficRecorder
+	// runID of every sent request, server scopes sequences and received counts to it
+	runID string
+
+	retryPolicy *RetryPolicy
+	retries     atomic.Uint64
+}
 
-	transportType   TransportType
-	transportScheme string
-	conn            *grpc.ClientConn
+// NewLogisticsClient instance with transport registered as cfg.TransportTypeProtocol,
+// every sent request is recorded if cfg.Traffic.RecordPath is set.
+func NewLogisticsClient(cfg *config.ClientAppConfig) (*APILogisticsClient, error) {
+	return NewLogisticsClientWithDialer(cfg, nil)
 }
 
-// NewLogisticsClient instance
-func NewLogisticsClient(cfg *config.ClientAppConfig) *APILogisticsClient {
-	return &APILogisticsClient{
-		transportType:   parseTransportType(cfg.TransportTypeProtocol),
-		transportScheme: cfg.Scheme,
-	}
+// NewLogisticsClientWithDialer that connects to server with dialer instead of network, nil dialer uses network
+func NewLogisticsClientWithDialer(cfg *config.ClientAppConfig, dialer Dialer) (*APILogisticsClient, error) {
+	return NewLogisticsClientWithTransportOptions(cfg, TransportOptions{Dialer: dialer})
 }
 
-// Connect to gRPC API
-func (lc *APILogisticsClient) Connect(serverAddr string, ctx context.Context) error {
-	if lc.transportType.Is(TransportTypeGRPC) {
-		conn, dialErr := grpc.DialContext(
-			ctx,
-			serverAddr,
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
-			grpc.WithBlock(),
-		)
-		if dialErr != nil {
-			return dialErr
+// NewLogisticsClientWithTransportOptions overriding cfg, TLS config and credentials are created from cfg if they are
+// nil in opts and scheme is always taken from cfg
+func NewLogisticsClientWithTransportOptions(cfg *config.ClientAppConfig, opts TransportOptions) (*APILogisticsClient, error) {
+	retryPolicy, policyErr := NewRetryPolicy(cfg.Retry, cfg.Seed)
+	if policyErr != nil {
+		return nil, policyErr
+	}
+
+	opts.Scheme = cfg.Scheme
+	if opts.TLSConfig == nil {
+		var tlsErr error
+		if opts.TLSConfig, tlsErr = NewTLSConfig(cfg.TLS); tlsErr != nil {
+			return nil, tlsErr
 		}
+	}
+	if opts.Credentials == nil {
+		var credentialsErr error
+		if opts.Credentials, credentialsErr = NewCredentials(cfg.Auth); credentialsErr != nil {
+			return nil, credentialsErr
+		}
+	}
 
-		lc.conn = conn
-		lc.apiClientGRPC = apiv1.NewCoopLogisticsEngineAPIClient(lc.conn)
+	transport, transportErr := NewTransport(cfg.TransportTypeProtocol, opts)
+	if transportErr != nil {
+		return nil, transportErr
+	}
 
-		return nil
+	transportName := cfg.TransportTypeProtocol
+	if len(transportName) == 0 {
+		transportName = DefaultTransport
 	}
 
-	httpConfig := openapi.NewConfiguration()
-	httpConfig.Host = serverAddr
-	httpConfig.Scheme = lc.transportScheme
-	lc.apiClientHTTP = openapi.NewAPIClient(httpConfig)
+	var recorder *TrafficRecorder
+	if len(cfg.Traffic.RecordPath) > 0 {
+		var recorderErr error
+		if recorder, recorderErr = NewTrafficRecorder(cfg.Traffic.RecordPath); recorderErr != nil {
+			return nil, recorderErr
+		}
+	}
 
-	return nil
+	return &APILogisticsClient{
+		transport:     transport,
+		transportName: transportName,
+		recorder:      recorder,
+		runID:         newRunID(),
+		retryPolicy:   retryPolicy,
+	}, nil
 }
 
-// Disconnect from gRPC API
+// Connect to API
+func (lc *APILogisticsClient) Connect(serverAddr string, ctx context.Context) error {
+	return lc.transport.Connect(ctx, serverAddr)
+}
+
+// Disconnect from API and flush recorded traffic, streaming transports verify that server accepted every sent move
 func (lc *APILogisticsClient) Disconnect() error {
-	return lc.conn.Close()
+	return errors.Join(lc.transport.Close(), lc.recorder.Close())
 }
 
-// MoveUnit to new location
-func (lc *APILogisticsClient) MoveUnit(ctx context.Context, req *apiv1.MoveUnitRequest) (responseErr error) {
-	if lc.transportType.Is(TransportTypeGRPC) {
-		_, responseErr = lc.apiClientGRPC.MoveUnit(ctx, req)
-		return
+// CloseMoveStream of streaming transport and verify that server accepted every sent move, no-op for other transports
+func (lc *APILogisticsClient) CloseMoveStream() error {
+	if streamer, ok := lc.transport.(MoveStreamer); ok {
+		return streamer.CloseMoveStream()
 	}
 
-	_, _, responseErr = lc.apiClientHTTP.CoopLogisticsEngineAPIAPI.
-		CoopLogisticsEngineAPIMoveUnit(ctx).
-		CargoUnitId(strconv.FormatInt(req.GetCargoUnitId(), 10)).
-		LocationLatitude(int64(req.GetLocation().GetLatitude())).
-		LocationLongitude(int64(req.GetLocation().GetLongitude())).
-		Execute()
+	return nil
+}
 
-	return
+// IsMoveStreamed if transport sends MoveUnit over stream that is acknowledged by CloseMoveStream
+func (lc *APILogisticsClient) IsMoveStreamed() bool {
+	_, ok := lc.transport.(MoveStreamer)
+	return ok
 }
 
-// UnitReachedWarehouse report that reach warehouse
-func (lc *APILogisticsClient) UnitReachedWarehouse(ctx context.Context, req *apiv1.UnitReachedWarehouseRequest) (responseErr error) {
-	if lc.transportType.Is(TransportTypeGRPC) {
-		_, responseErr = lc.apiClientGRPC.UnitReachedWarehouse(ctx, req)
-		return
+// RunID of requests sent by client, generated when client is created so runs against the same server do not mix
+func (lc *APILogisticsClient) RunID() string {
+	return lc.runID
+}
+
+// MoveUnit to new location in run of client, assigns IdempotencyKey if empty and retries transient failures
+// unless moves are streamed
+func (lc *APILogisticsClient) MoveUnit(ctx context.Context, req *apiv1.MoveUnitRequest) error {
+	if len(req.GetIdempotencyKey()) == 0 {
+		req.IdempotencyKey = newIdempotencyKey()
+	}
+	req.RunId = lc.runID
+
+	sentAt := time.Now()
+	lc.recorder.Start(sentAt)
+	var sendErr error
+	if _, ok := lc.transport.(MoveStreamer); ok {
+		sendErr = lc.transport.MoveUnit(ctx, req)
+	} else {
+		sendErr = lc.retryPolicy.Do(ctx, func() error {
+			return lc.transport.MoveUnit(ctx, req)
+		}, lc.countRetry)
+	}
+
+	lc.record(sentAt, &apiv1.TrafficRecord{Request: &apiv1.TrafficRecord_MoveUnit{MoveUnit: req}}, sendErr)
+
+	return sendErr
+}
+
+// UnitReachedWarehouse report that reach warehouse in run of client, assigns IdempotencyKey if empty and retries
+// transient failures
+func (lc *APILogisticsClient) UnitReachedWarehouse(ctx context.Context, req *apiv1.UnitReachedWarehouseRequest) error {
+	if len(req.GetIdempotencyKey()) == 0 {
+		req.IdempotencyKey = newIdempotencyKey()
+	}
+	req.RunId = lc.runID
+
+	sentAt := time.Now()
+	lc.recorder.Start(sentAt)
+	sendErr := lc.retryPolicy.Do(ctx, func() error {
+		return lc.transport.UnitReachedWarehouse(ctx, req)
+	}, lc.countRetry)
+
+	lc.record(sentAt, &apiv1.TrafficRecord{Request: &apiv1.TrafficRecord_UnitReachedWarehouse{UnitReachedWarehouse: req}}, sendErr)
+
+	return sendErr
+}
+
+// GetReceivedCounts of messages server accepted per cargo unit in run of client,
+// ErrNotSupported if server or transport does not implement it.
+func (lc *APILogisticsClient) GetReceivedCounts(ctx context.Context) ([]*apiv1.CargoUnitReceivedCounts, error) {
+	reporter, ok := lc.transport.(ReceivedCountsReporter)
+	if !ok {
+		return nil, fmt.Errorf("GetReceivedCounts %w: transport does not support it", ErrNotSupported)
 	}
 
-	_, _, responseErr = lc.apiClientHTTP.CoopLogisticsEngineAPIAPI.
-		CoopLogisticsEngineAPIUnitReachedWarehouse(ctx).
-		AnnouncementCargoUnitId(strconv.FormatInt(req.GetAnnouncement().GetCargoUnitId(), 10)).
-		AnnouncementWarehouseId(strconv.FormatInt(req.GetAnnouncement().GetWarehouseId(), 10)).
-		AnnouncementMessage(req.GetAnnouncement().GetMessage()).
-		LocationLatitude(int64(req.GetLocation().GetLatitude())).
-		LocationLongitude(int64(req.GetLocation().GetLongitude())).
-		Execute()
+	return reporter.GetReceivedCounts(ctx, &apiv1.GetReceivedCountsRequest{RunId: lc.runID})
+}
 
-	return
+// Retries of failed requests made since client creation
+func (lc *APILogisticsClient) Retries() uint64 {
+	return lc.retries.Load()
 }
 
-// Is TransportType matching needed one.
-func (tt *TransportType) Is(needed TransportType) bool {
-	return tt != nil && *tt == needed
+func (lc *APILogisticsClient) countRetry() {
+	lc.retries.Add(1)
 }
 
-// parseTransportType allows parse string and get supported TransportType or fallback to default.
-func parseTransportType(transportType string) TransportType {
-	switch transportType {
-	case TransportTypeHTTPStr:
-		return TransportTypeHTTP
-	default:
-		return TransportTypeGRPC
+// record request sent at sentAt with its result if recording is enabled
+func (lc *APILogisticsClient) record(sentAt time.Time, record *apiv1.TrafficRecord, sendErr error) {
+	if lc.recorder == nil {
+		return
 	}
+
+	record.Transport = lc.transportName
+	record.Latency = durationpb.New(time.Since(sentAt))
+	if sendErr != nil {
+		record.Error = sendErr.Error()
+	}
+
+	lc.recorder.Record(sentAt, record)
+}
+
+// newRunID random for every client, like idempotency key of whole run
+func newRunID() string {
+	return newIdempotencyKey()
 }
@@ -0,0 +1,251 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/apitest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var builtInTransports = []string{
+	TransportTypeGRPCStr,
+	TransportTypeGRPCStreamStr,
+	TransportTypeHTTPStr,
+	TransportTypeHTTPJSONStr,
+	TransportTypeHTTPProtobufStr,
+}
+
+// connectFakeServer client of transport to fake server, gRPC over in-memory connection and HTTP over local test server
+func connectFakeServer(t *testing.T, transport string, srv *apitest.Server) *APILogisticsClient {
+	t.Helper()
+
+	cfg := &config.ClientAppConfig{}
+	cfg.LoadDefaults()
+	cfg.TransportTypeProtocol = transport
+	cfg.Retry.InitialBackoff = time.Millisecond
+
+	var dialer Dialer
+	addr := "bufconn"
+	if transport == TransportTypeGRPCStr || transport == TransportTypeGRPCStreamStr {
+		dialer = apitest.ServeGRPC(t, srv)
+	} else {
+		addr = apitest.ServeHTTP(t, srv)
+	}
+
+	lc, clientErr := NewLogisticsClientWithDialer(cfg, dialer)
+	if clientErr != nil {
+		t.Fatalf("Not expected error from NewLogisticsClientWithDialer, error: %v", clientErr)
+	}
+
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+	if connErr := lc.Connect(addr, ctx); connErr != nil {
+		t.Fatalf("Not expected error from Connect, error: %v", connErr)
+	}
+	t.Cleanup(func() { _ = lc.Disconnect() })
+
+	return lc
+}
+
+func TestAPILogisticsClientTransports(t *testing.T) {
+	for _, transport := range builtInTransports {
+		t.Run(transport, func(t *testing.T) {
+			srv := apitest.NewServer()
+			lc := connectFakeServer(t, transport, srv)
+			ctx := context.Background()
+
+			for sequence := uint64(1); sequence <= 3; sequence++ {
+				moveErr := lc.MoveUnit(ctx, &apiv1.MoveUnitRequest{
+					CargoUnitId: 7,
+					Location:    &apiv1.Location{Latitude: uint32(sequence), Longitude: 2},
+					Sequence:    sequence,
+				})
+				if moveErr != nil {
+					t.Fatalf("Not expected error from MoveUnit, error: %v", moveErr)
+				}
+			}
+			reachErr := lc.UnitReachedWarehouse(ctx, &apiv1.UnitReachedWarehouseRequest{
+				Location:     &apiv1.Location{Latitude: 3, Longitude: 2},
+				Announcement: &apiv1.WarehouseAnnouncement{CargoUnitId: 7, WarehouseId: 1, Message: "reached"},
+				Sequence:     4,
+			})
+			if reachErr != nil {
+				t.Fatalf("Not expected error from UnitReachedWarehouse, error: %v", reachErr)
+			}
+			if streamErr := lc.CloseMoveStream(); streamErr != nil {
+				t.Fatalf("Not expected error from CloseMoveStream, error: %v", streamErr)
+			}
+
+			moves := srv.Moves()
+			if len(moves) != 3 {
+				t.Fatalf("Expected 3 moves received by server, but got %d", len(moves))
+			}
+			for i, move := range moves {
+				if move.GetCargoUnitId() != 7 || move.GetSequence() != uint64(i+1) || len(move.GetIdempotencyKey()) == 0 ||
+					move.GetRunId() != lc.RunID() {
+					t.Errorf("Expected move %d of cargo unit 7 with idempotency key in run %s, but got %v", i+1, lc.RunID(), move)
+				}
+			}
+			reached := srv.Reached()
+			if len(reached) != 1 || reached[0].GetAnnouncement().GetWarehouseId() != 1 || reached[0].GetSequence() != 4 ||
+				reached[0].GetRunId() != lc.RunID() {
+				t.Errorf("Expected announcement of warehouse 1, but got %v", reached)
+			}
+
+			counts, countsErr := lc.GetReceivedCounts(ctx)
+			if countsErr != nil {
+				t.Fatalf("Not expected error from GetReceivedCounts, error: %v", countsErr)
+			}
+			if len(counts) != 1 || counts[0].GetMoveUnitCount() != 3 || counts[0].GetUnitReachedWarehouseCount() != 1 {
+				t.Errorf("Expected counts of 3 moves and 1 announcement, but got %v", counts)
+			}
+		})
+	}
+}
+
+func TestAPILogisticsClientRetriesUnavailableServer(t *testing.T) {
+	for _, transport := range []string{TransportTypeGRPCStr, TransportTypeHTTPStr} {
+		t.Run(transport, func(t *testing.T) {
+			srv := apitest.NewServer()
+			srv.SetBehavior(apitest.MethodMoveUnit, apitest.FailFirst(2, status.Error(codes.Unavailable, "down")))
+			lc := connectFakeServer(t, transport, srv)
+
+			if err := lc.MoveUnit(context.Background(), &apiv1.MoveUnitRequest{CargoUnitId: 1}); err != nil {
+				t.Fatalf("Not expected error from MoveUnit, error: %v", err)
+			}
+			if calls := srv.Calls(apitest.MethodMoveUnit); calls != 3 || lc.Retries() != 2 {
+				t.Errorf("Expected 3 calls with 2 retries, but got %d calls and %d retries", calls, lc.Retries())
+			}
+			if moves := srv.Moves(); len(moves) != 1 {
+				t.Errorf("Expected single accepted move, but got %d", len(moves))
+			}
+		})
+	}
+}
+
+func TestAPILogisticsClientDoesNotRetryRejectedRequest(t *testing.T) {
+	for _, transport := range []string{TransportTypeGRPCStr, TransportTypeHTTPStr} {
+		t.Run(transport, func(t *testing.T) {
+			srv := apitest.NewServer()
+			srv.SetBehavior(apitest.MethodUnitReachedWarehouse, apitest.Respond(apitest.Response{
+				Err: status.Error(codes.InvalidArgument, "unknown warehouse"),
+			}))
+			lc := connectFakeServer(t, transport, srv)
+
+			if err := lc.UnitReachedWarehouse(context.Background(), &apiv1.UnitReachedWarehouseRequest{}); err == nil {
+				t.Errorf("Expected error from rejected UnitReachedWarehouse")
+			}
+			if calls := srv.Calls(apitest.MethodUnitReachedWarehouse); calls != 1 || lc.Retries() != 0 {
+				t.Errorf("Expected single call without retries, but got %d calls and %d retries", calls, lc.Retries())
+			}
+		})
+	}
+}
+
+func TestAPILogisticsClientDroppedCall(t *testing.T) {
+	for _, transport := range []string{TransportTypeGRPCStr, TransportTypeHTTPStr} {
+		t.Run(transport, func(t *testing.T) {
+			srv := apitest.NewServer()
+			srv.SetBehavior(apitest.MethodMoveUnit, apitest.Respond(apitest.Response{Drop: true}))
+			lc := connectFakeServer(t, transport, srv)
+
+			ctx, ctxCancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer ctxCancel()
+
+			moveErr := lc.MoveUnit(ctx, &apiv1.MoveUnitRequest{CargoUnitId: 1})
+			if status.Code(moveErr) != codes.DeadlineExceeded && !errors.Is(moveErr, context.DeadlineExceeded) {
+				t.Errorf("Expected deadline of dropped call to be exceeded, but got %v", moveErr)
+			}
+			if moves := srv.Moves(); len(moves) != 0 {
+				t.Errorf("Expected dropped move not to be accepted, but got %d", len(moves))
+			}
+		})
+	}
+}
+
+func TestAPILogisticsClientStreamRejectedMoves(t *testing.T) {
+	srv := apitest.NewServer()
+	srv.SetBehavior(apitest.MethodMoveUnits, apitest.FailEvery(2, status.Error(codes.Internal, "not stored")))
+	lc := connectFakeServer(t, TransportTypeGRPCStreamStr, srv)
+
+	for i := int64(1); i <= 4; i++ {
+		if err := lc.MoveUnit(context.Background(), &apiv1.MoveUnitRequest{CargoUnitId: i}); err != nil {
+			t.Fatalf("Not expected error from streamed MoveUnit, error: %v", err)
+		}
+	}
+
+	if err := lc.CloseMoveStream(); err == nil {
+		t.Errorf("Expected error when server rejected streamed moves")
+	}
+	if moves := srv.Moves(); len(moves) != 2 {
+		t.Errorf("Expected 2 accepted moves, but got %d", len(moves))
+	}
+}
+
+func TestNewLogisticsClientWithDialerUsesDialer(t *testing.T) {
+	srv := apitest.NewServer()
+	serve := apitest.ServeGRPC(t, srv)
+
+	var dialed string
+	cfg := &config.ClientAppConfig{}
+	cfg.LoadDefaults()
+	lc, clientErr := NewLogisticsClientWithDialer(cfg, func(ctx context.Context, addr string) (net.Conn, error) {
+		dialed = addr
+		return serve(ctx, addr)
+	})
+	if clientErr != nil {
+		t.Fatalf("Not expected error from NewLogisticsClientWithDialer, error: %v", clientErr)
+	}
+	defer lc.Disconnect()
+
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+	if err := lc.Connect("logistics.test:50051", ctx); err != nil {
+		t.Fatalf("Not expected error from Connect, error: %v", err)
+	}
+	if dialed != "logistics.test:50051" {
+		t.Errorf("Expected dialer to connect to configured address, but got %q", dialed)
+	}
+}
+
+func TestAPILogisticsClientStreamSendCancelledByContext(t *testing.T) {
+	srv := apitest.NewServer()
+	// Server stops reading stream, so client sends block once flow control window is full
+	srv.SetBehavior(apitest.MethodMoveUnits, apitest.Respond(apitest.Response{Drop: true}))
+	lc := connectFakeServer(t, TransportTypeGRPCStreamStr, srv)
+
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer ctxCancel()
+
+	done := make(chan error, 1)
+	go func() {
+		req := &apiv1.MoveUnitRequest{CargoUnitId: 1, IdempotencyKey: strings.Repeat("k", 1024)}
+		for {
+			if err := lc.MoveUnit(ctx, req); err != nil {
+				done <- err
+				return
+			}
+		}
+	}()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected blocked send to end with deadline of caller, but got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Expected blocked send to return when context is done")
+	}
+
+	if err := lc.CloseMoveStream(); err == nil {
+		t.Errorf("Expected error from CloseMoveStream of cancelled stream")
+	}
+}
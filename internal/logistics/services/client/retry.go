@@ -0,0 +1,164 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	mathrand "math/rand"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+	"github.com/coopnorge/interview-backend/internal/pkg/generator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryPolicy of requests that failed with transient error
+type RetryPolicy struct {
+	// MaxAttempts including first one, 1 disables retries.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Jitter fraction of backoff that is randomized, from 0 to 1.
+	Jitter float64
+	// Random source of jitter, seeded so retry timing of seeded run is reproducible.
+	Random *mathrand.Rand
+
+	GRPCCodes    map[codes.Code]struct{}
+	HTTPStatuses map[int]struct{}
+}
+
+// httpStatusError keeps status of failed HTTP response, so it can be checked by RetryPolicy
+type httpStatusError struct {
+	statusCode int
+	err        error
+}
+
+func (e *httpStatusError) Error() string {
+	return e.err.Error()
+}
+
+func (e *httpStatusError) Unwrap() error {
+	return e.err
+}
+
+// NewRetryPolicy from configuration with jitter drawn from generator seeded with seed,
+// fails on unknown gRPC code or invalid HTTP status
+func NewRetryPolicy(cfg config.ClientRetryConfig, seed int64) (*RetryPolicy, error) {
+	policy := &RetryPolicy{
+		MaxAttempts:    cfg.MaxAttempts,
+		InitialBackoff: cfg.InitialBackoff,
+		MaxBackoff:     cfg.MaxBackoff,
+		Jitter:         cfg.Jitter,
+		Random:         generator.NewRand(seed),
+		GRPCCodes:      make(map[codes.Code]struct{}),
+		HTTPStatuses:   make(map[int]struct{}),
+	}
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+
+	for _, name := range cfg.GRPCCodes {
+		code, ok := parseGRPCCode(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown gRPC code %q in retry policy", name)
+		}
+		policy.GRPCCodes[code] = struct{}{}
+	}
+	for _, value := range cfg.HTTPStatuses {
+		statusCode, parseErr := strconv.Atoi(value)
+		if parseErr != nil {
+			return nil, fmt.Errorf("invalid HTTP status %q in retry policy, error: %w", value, parseErr)
+		}
+		policy.HTTPStatuses[statusCode] = struct{}{}
+	}
+
+	return policy, nil
+}
+
+// Do call until it succeeds, fails with not retryable error or attempts are exhausted,
+// onRetry is called before every repeated attempt.
+func (p *RetryPolicy) Do(ctx context.Context, call func() error, onRetry func()) error {
+	for attempt := 1; ; attempt++ {
+		callErr := call()
+		if callErr == nil || attempt >= p.MaxAttempts || !p.IsRetryable(callErr) {
+			return callErr
+		}
+
+		timer := time.NewTimer(p.Backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Join(callErr, ctx.Err())
+		case <-timer.C:
+		}
+
+		if onRetry != nil {
+			onRetry()
+		}
+	}
+}
+
+// IsRetryable error of gRPC or HTTP request, HTTP requests that failed without response are retried too
+func (p *RetryPolicy) IsRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) {
+		_, ok := p.HTTPStatuses[statusErr.statusCode]
+		return ok
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return true
+	}
+
+	if s, ok := status.FromError(err); ok {
+		_, retryable := p.GRPCCodes[s.Code()]
+		return retryable
+	}
+
+	return false
+}
+
+// Backoff before next attempt after failed one, attempts are counted from 1
+func (p *RetryPolicy) Backoff(attempt int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+
+	if p.Jitter > 0 {
+		backoff += time.Duration((p.Random.Float64()*2 - 1) * p.Jitter * float64(backoff))
+	}
+
+	return backoff
+}
+
+// newIdempotencyKey random for single request and all its retries
+func newIdempotencyKey() string {
+	key := make([]byte, 16)
+	_, _ = rand.Read(key)
+
+	return hex.EncodeToString(key)
+}
+
+func parseGRPCCode(name string) (codes.Code, bool) {
+	for code := codes.OK; code <= codes.Unauthenticated; code++ {
+		if code.String() == name {
+			return code, true
+		}
+	}
+
+	return codes.Unknown, false
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestRetryPolicy(t *testing.T, maxAttempts int) *RetryPolicy {
+	policy, policyErr := NewRetryPolicy(config.ClientRetryConfig{
+		MaxAttempts:    maxAttempts,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     4 * time.Millisecond,
+		GRPCCodes:      []string{"Unavailable"},
+		HTTPStatuses:   []string{"503"},
+	}, 1)
+	if policyErr != nil {
+		t.Fatalf("Not expected error from NewRetryPolicy, error: %v", policyErr)
+	}
+
+	return policy
+}
+
+func TestNewRetryPolicyRejectsUnknownCode(t *testing.T) {
+	if _, err := NewRetryPolicy(config.ClientRetryConfig{GRPCCodes: []string{"Sometimes"}}, 1); err == nil {
+		t.Errorf("Expected error for unknown gRPC code")
+	}
+	if _, err := NewRetryPolicy(config.ClientRetryConfig{HTTPStatuses: []string{"5xx"}}, 1); err == nil {
+		t.Errorf("Expected error for invalid HTTP status")
+	}
+}
+
+func TestRetryPolicyIsRetryable(t *testing.T) {
+	policy := newTestRetryPolicy(t, 3)
+
+	cases := []struct {
+		name      string
+		err       error
+		retryable bool
+	}{
+		{name: "gRPC retryable code", err: status.Error(codes.Unavailable, "down"), retryable: true},
+		{name: "gRPC other code", err: status.Error(codes.InvalidArgument, "bad"), retryable: false},
+		{name: "HTTP retryable status", err: &httpStatusError{statusCode: 503, err: errors.New("503")}, retryable: true},
+		{name: "HTTP other status", err: &httpStatusError{statusCode: 400, err: errors.New("400")}, retryable: false},
+		{name: "HTTP without response", err: &url.Error{Op: "Post", URL: "http://host", Err: errors.New("refused")}, retryable: true},
+		{name: "canceled", err: status.FromContextError(context.Canceled).Err(), retryable: false},
+		{name: "context canceled", err: context.Canceled, retryable: false},
+	}
+	for _, c := range cases {
+		if retryable := policy.IsRetryable(c.err); retryable != c.retryable {
+			t.Errorf("%s: expected retryable %t, but got %t", c.name, c.retryable, retryable)
+		}
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := newTestRetryPolicy(t, 10)
+
+	expected := []time.Duration{time.Millisecond, 2 * time.Millisecond, 4 * time.Millisecond, 4 * time.Millisecond}
+	for i, e := range expected {
+		if backoff := policy.Backoff(i + 1); backoff != e {
+			t.Errorf("Expected backoff %s after attempt %d, but got %s", e, i+1, backoff)
+		}
+	}
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if backoff := policy.Backoff(3); backoff < 2*time.Millisecond || backoff > 6*time.Millisecond {
+			t.Fatalf("Expected jittered backoff within 50%% of 4ms, but got %s", backoff)
+		}
+	}
+
+	// Same seed gives the same jitter
+	first, second := newTestRetryPolicy(t, 10), newTestRetryPolicy(t, 10)
+	first.Jitter, second.Jitter = 0.5, 0.5
+	for i := 0; i < 10; i++ {
+		if a, b := first.Backoff(3), second.Backoff(3); a != b {
+			t.Fatalf("Expected same jittered backoff of policies with same seed, but got %s and %s", a, b)
+		}
+	}
+}
+
+func TestRetryPolicyDo(t *testing.T) {
+	policy := newTestRetryPolicy(t, 3)
+	unavailable := status.Error(codes.Unavailable, "down")
+
+	calls, retries := 0, 0
+	err := policy.Do(context.Background(), func() error {
+		calls++
+		if calls < 3 {
+			return unavailable
+		}
+		return nil
+	}, func() { retries++ })
+	if err != nil || calls != 3 || retries != 2 {
+		t.Errorf("Expected success on third attempt after 2 retries, but got %d calls, %d retries, error: %v", calls, retries, err)
+	}
+
+	calls = 0
+	err = policy.Do(context.Background(), func() error {
+		calls++
+		return unavailable
+	}, nil)
+	if !errors.Is(err, unavailable) || calls != 3 {
+		t.Errorf("Expected last error after 3 attempts, but got %d calls, error: %v", calls, err)
+	}
+
+	calls = 0
+	_ = policy.Do(context.Background(), func() error {
+		calls++
+		return status.Error(codes.InvalidArgument, "bad")
+	}, nil)
+	if calls != 1 {
+		t.Errorf("Expected not retryable error to be returned after first attempt, but got %d calls", calls)
+	}
+}
@@ -0,0 +1,49 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+)
+
+// NewTLSConfig for gRPC and HTTP transports, nil if TLS is not enabled
+func NewTLSConfig(cfg config.ClientTLSConfig) (*tls.Config, error) {
+	if !cfg.IsEnabled() {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: cfg.ServerName,
+	}
+
+	if len(cfg.CAFile) > 0 {
+		caPEM, readErr := os.ReadFile(cfg.CAFile)
+		if readErr != nil {
+			return nil, fmt.Errorf("failed to read TLS CA file, error: %w", readErr)
+		}
+
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificates found in TLS CA file %s", cfg.CAFile)
+		}
+	}
+
+	if len(cfg.CertFile) > 0 || len(cfg.KeyFile) > 0 {
+		if len(cfg.CertFile) == 0 || len(cfg.KeyFile) == 0 {
+			return nil, errors.New("both TLS cert and key files are required for mutual TLS")
+		}
+
+		cert, loadErr := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if loadErr != nil {
+			return nil, fmt.Errorf("failed to load TLS client certificate, error: %w", loadErr)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
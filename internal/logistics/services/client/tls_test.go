@@ -0,0 +1,248 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/server"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/store"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const testServerName = "logistics.test"
+
+// testPKI of self-signed CA with server and client certificates written as PEM files
+type testPKI struct {
+	caFile, serverCertFile, serverKeyFile, clientCertFile, clientKeyFile string
+
+	caPool *x509.CertPool
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	dir := t.TempDir()
+
+	caKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, caErr := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if caErr != nil {
+		t.Fatalf("Not expected error creating CA, error: %v", caErr)
+	}
+	caCert, _ := x509.ParseCertificate(caDER)
+
+	issue := func(serial int64, name string, usage x509.ExtKeyUsage) (string, string) {
+		key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: name},
+			DNSNames:     []string{name},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		}
+		der, certErr := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+		if certErr != nil {
+			t.Fatalf("Not expected error creating certificate, error: %v", certErr)
+		}
+		keyDER, _ := x509.MarshalECPrivateKey(key)
+
+		certFile := filepath.Join(dir, name+".crt")
+		keyFile := filepath.Join(dir, name+".key")
+		writePEM(t, certFile, "CERTIFICATE", der)
+		writePEM(t, keyFile, "EC PRIVATE KEY", keyDER)
+
+		return certFile, keyFile
+	}
+
+	pki := &testPKI{caFile: filepath.Join(dir, "ca.crt"), caPool: x509.NewCertPool()}
+	writePEM(t, pki.caFile, "CERTIFICATE", caDER)
+	pki.caPool.AddCert(caCert)
+	pki.serverCertFile, pki.serverKeyFile = issue(2, testServerName, x509.ExtKeyUsageServerAuth)
+	pki.clientCertFile, pki.clientKeyFile = issue(3, "client.test", x509.ExtKeyUsageClientAuth)
+
+	return pki
+}
+
+// serverTLSConfig that requires client certificate signed by test CA
+func (p *testPKI) serverTLSConfig(t *testing.T) *tls.Config {
+	cert, loadErr := tls.LoadX509KeyPair(p.serverCertFile, p.serverKeyFile)
+	if loadErr != nil {
+		t.Fatalf("Not expected error loading server certificate, error: %v", loadErr)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    p.caPool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}
+}
+
+func writePEM(t *testing.T, path, blockType string, der []byte) {
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600); err != nil {
+		t.Fatalf("Not expected error writing %s, error: %v", path, err)
+	}
+}
+
+func newTestTLSClient(t *testing.T, transportType string, tlsConfig config.ClientTLSConfig) *APILogisticsClient {
+	lc, clientErr := NewLogisticsClient(&config.ClientAppConfig{
+		TransportTypeProtocol: transportType,
+		Retry:                 config.ClientRetryConfig{MaxAttempts: 1},
+		TLS:                   tlsConfig,
+	})
+	if clientErr != nil {
+		t.Fatalf("Not expected error from NewLogisticsClient, error: %v", clientErr)
+	}
+
+	return lc
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	pki := newTestPKI(t)
+
+	if tlsConfig, err := NewTLSConfig(config.ClientTLSConfig{}); tlsConfig != nil || err != nil {
+		t.Errorf("Expected no TLS when it is not configured, but got %v, error: %v", tlsConfig, err)
+	}
+	if _, err := NewTLSConfig(config.ClientTLSConfig{CertFile: pki.clientCertFile}); err == nil {
+		t.Errorf("Expected error for client certificate without key")
+	}
+	if _, err := NewTLSConfig(config.ClientTLSConfig{CAFile: pki.clientKeyFile}); err == nil {
+		t.Errorf("Expected error for CA file without certificates")
+	}
+
+	tlsConfig, err := NewTLSConfig(config.ClientTLSConfig{
+		CAFile:     pki.caFile,
+		CertFile:   pki.clientCertFile,
+		KeyFile:    pki.clientKeyFile,
+		ServerName: testServerName,
+	})
+	if err != nil {
+		t.Fatalf("Not expected error from NewTLSConfig, error: %v", err)
+	}
+	if tlsConfig.RootCAs == nil || len(tlsConfig.Certificates) != 1 || tlsConfig.ServerName != testServerName {
+		t.Errorf("Expected CA, client certificate and server name to be configured, but got %+v", tlsConfig)
+	}
+}
+
+func TestAPILogisticsClientMutualTLSOverGRPC(t *testing.T) {
+	pki := newTestPKI(t)
+
+	listener, listenErr := net.Listen("tcp", "127.0.0.1:0")
+	if listenErr != nil {
+		t.Fatalf("Not expected error from Listen, error: %v", listenErr)
+	}
+	grpcServer := grpc.NewServer(grpc.Creds(credentials.NewTLS(pki.serverTLSConfig(t))))
+	apiv1.RegisterCoopLogisticsEngineAPIServer(grpcServer, server.NewLogisticsServer(
+		store.NewMemoryStore(),
+		server.NewMovementBroker(),
+		server.NewUnitCounter(),
+		server.NewIdempotencyCache(),
+	))
+	go func() { _ = grpcServer.Serve(listener) }()
+	defer grpcServer.Stop()
+
+	cases := []struct {
+		name      string
+		tlsConfig config.ClientTLSConfig
+		success   bool
+	}{
+		{
+			name: "mutual TLS",
+			tlsConfig: config.ClientTLSConfig{
+				CAFile:     pki.caFile,
+				CertFile:   pki.clientCertFile,
+				KeyFile:    pki.clientKeyFile,
+				ServerName: testServerName,
+			},
+			success: true,
+		},
+		{
+			name:      "without client certificate",
+			tlsConfig: config.ClientTLSConfig{CAFile: pki.caFile, ServerName: testServerName},
+		},
+		{
+			name: "server name does not match certificate",
+			tlsConfig: config.ClientTLSConfig{
+				CAFile:   pki.caFile,
+				CertFile: pki.clientCertFile,
+				KeyFile:  pki.clientKeyFile,
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			lc := newTestTLSClient(t, TransportTypeGRPCStr, c.tlsConfig)
+
+			ctx, ctxCancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer ctxCancel()
+
+			err := lc.Connect(listener.Addr().String(), ctx)
+			if err == nil {
+				defer func() { _ = lc.Disconnect() }()
+				err = lc.MoveUnit(ctx, &apiv1.MoveUnitRequest{CargoUnitId: 1, Location: &apiv1.Location{}})
+			}
+
+			if c.success && err != nil {
+				t.Errorf("Not expected error, error: %v", err)
+			} else if !c.success && err == nil {
+				t.Errorf("Expected TLS error")
+			}
+		})
+	}
+}
+
+func TestAPILogisticsClientMutualTLSOverHTTP(t *testing.T) {
+	pki := newTestPKI(t)
+
+	httpServer := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("{}"))
+	}))
+	httpServer.TLS = pki.serverTLSConfig(t)
+	httpServer.StartTLS()
+	defer httpServer.Close()
+
+	lc := newTestTLSClient(t, TransportTypeHTTPStr, config.ClientTLSConfig{
+		CAFile:     pki.caFile,
+		CertFile:   pki.clientCertFile,
+		KeyFile:    pki.clientKeyFile,
+		ServerName: testServerName,
+	})
+	if err := lc.Connect(httpServer.Listener.Addr().String(), context.Background()); err != nil {
+		t.Fatalf("Not expected error from Connect, error: %v", err)
+	}
+
+	if err := lc.MoveUnit(context.Background(), &apiv1.MoveUnitRequest{CargoUnitId: 1, Location: &apiv1.Location{}}); err != nil {
+		t.Errorf("Not expected error from MoveUnit, error: %v", err)
+	}
+
+	untrusted := newTestTLSClient(t, TransportTypeHTTPStr, config.ClientTLSConfig{ServerName: testServerName})
+	_ = untrusted.Connect(httpServer.Listener.Addr().String(), context.Background())
+	if err := untrusted.MoveUnit(context.Background(), &apiv1.MoveUnitRequest{CargoUnitId: 1, Location: &apiv1.Location{}}); err == nil {
+		t.Errorf("Expected error for server signed by unknown CA")
+	}
+}
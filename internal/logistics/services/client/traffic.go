@@ -0,0 +1,201 @@
+package client
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// maxTrafficLineSize of single NDJSON record
+const maxTrafficLineSize = 1 << 20
+
+// TrafficFormat of recorded traffic file
+type TrafficFormat byte
+
+const (
+	// TrafficFormatNDJSON one protojson encoded record per line.
+	TrafficFormatNDJSON TrafficFormat = iota
+	// TrafficFormatProtobuf varint length-delimited binary records.
+	TrafficFormatProtobuf
+)
+
+// TrafficFormatFromPath by file extension, .ndjson, .jsonl and .json files are NDJSON, other are protobuf
+func TrafficFormatFromPath(path string) TrafficFormat {
+	switch filepath.Ext(path) {
+	case ".ndjson", ".jsonl", ".json":
+		return TrafficFormatNDJSON
+	default:
+		return TrafficFormatProtobuf
+	}
+}
+
+// TrafficRecorder writes every sent request with its result to file
+type TrafficRecorder struct {
+	file   *os.File
+	writer *bufio.Writer
+	format TrafficFormat
+	// start of recording clock, when first recorded request was sent
+	start time.Time
+	// writeErr is first failed write, returned on Close
+	writeErr error
+
+	sync.Mutex
+}
+
+// NewTrafficRecorder that truncates file at path, offsets of records are relative to first recorded request
+func NewTrafficRecorder(path string) (*TrafficRecorder, error) {
+	file, openErr := os.Create(path)
+	if openErr != nil {
+		return nil, fmt.Errorf("failed to create traffic record file, error: %w", openErr)
+	}
+
+	return &TrafficRecorder{
+		file:   file,
+		writer: bufio.NewWriter(file),
+		format: TrafficFormatFromPath(path),
+	}, nil
+}
+
+// Start clock of recording when request is sent at sentAt, clock starts at the earliest one.
+// Nil recorder does nothing.
+func (r *TrafficRecorder) Start(sentAt time.Time) {
+	if r == nil {
+		return
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if r.start.IsZero() || sentAt.Before(r.start) {
+		r.start = sentAt
+	}
+}
+
+// Record request sent at sentAt, nil recorder does nothing
+func (r *TrafficRecorder) Record(sentAt time.Time, record *apiv1.TrafficRecord) {
+	if r == nil {
+		return
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if r.writeErr != nil {
+		return
+	}
+
+	if r.start.IsZero() {
+		r.start = sentAt
+	}
+	record.Offset = durationpb.New(max(sentAt.Sub(r.start), 0))
+	if r.format == TrafficFormatProtobuf {
+		_, r.writeErr = protodelim.MarshalTo(r.writer, record)
+		return
+	}
+
+	line, marshalErr := protojson.Marshal(record)
+	if marshalErr != nil {
+		r.writeErr = marshalErr
+		return
+	}
+	if _, r.writeErr = r.writer.Write(line); r.writeErr == nil {
+		r.writeErr = r.writer.WriteByte('\n')
+	}
+}
+
+// Close file after flushing records, returns first write error, nil recorder does nothing
+func (r *TrafficRecorder) Close() error {
+	if r == nil {
+		return nil
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+
+	flushErr := r.writer.Flush()
+	closeErr := r.file.Close()
+	r.file = nil
+
+	if r.writeErr != nil {
+		return fmt.Errorf("failed to record traffic, error: %w", r.writeErr)
+	}
+
+	return errors.Join(flushErr, closeErr)
+}
+
+// ReadTraffic recorded by TrafficRecorder, ordered by offset
+func ReadTraffic(path string) ([]*apiv1.TrafficRecord, error) {
+	file, openErr := os.Open(path)
+	if openErr != nil {
+		return nil, fmt.Errorf("failed to open traffic record file, error: %w", openErr)
+	}
+	defer file.Close()
+
+	var records []*apiv1.TrafficRecord
+	var readErr error
+	if TrafficFormatFromPath(path) == TrafficFormatProtobuf {
+		records, readErr = readProtobufTraffic(file)
+	} else {
+		records, readErr = readNDJSONTraffic(file)
+	}
+	if readErr != nil {
+		return nil, fmt.Errorf("failed to read traffic record file %s, error: %w", path, readErr)
+	}
+
+	// Records are written when request completes, so concurrent requests may be out of order
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].GetOffset().AsDuration() < records[j].GetOffset().AsDuration()
+	})
+
+	return records, nil
+}
+
+func readNDJSONTraffic(r io.Reader) ([]*apiv1.TrafficRecord, error) {
+	var records []*apiv1.TrafficRecord
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxTrafficLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		record := &apiv1.TrafficRecord{}
+		if err := protojson.Unmarshal(scanner.Bytes(), record); err != nil {
+			return nil, fmt.Errorf("invalid record on line %d, error: %w", line, err)
+		}
+		records = append(records, record)
+	}
+
+	return records, scanner.Err()
+}
+
+func readProtobufTraffic(r io.Reader) ([]*apiv1.TrafficRecord, error) {
+	var records []*apiv1.TrafficRecord
+
+	reader := bufio.NewReader(r)
+	for {
+		record := &apiv1.TrafficRecord{}
+		if err := protodelim.UnmarshalFrom(reader, record); errors.Is(err, io.EOF) {
+			return records, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("invalid record %d, error: %w", len(records)+1, err)
+		}
+		records = append(records, record)
+	}
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTrafficFormatFromPath(t *testing.T) {
+	cases := map[string]TrafficFormat{
+		"traffic.ndjson": TrafficFormatNDJSON,
+		"traffic.jsonl":  TrafficFormatNDJSON,
+		"traffic.json":   TrafficFormatNDJSON,
+		"traffic.pb":     TrafficFormatProtobuf,
+		"traffic":        TrafficFormatProtobuf,
+	}
+
+	for path, expected := range cases {
+		if format := TrafficFormatFromPath(path); format != expected {
+			t.Errorf("Expected format %d for %s, but got %d", expected, path, format)
+		}
+	}
+}
+
+func TestTrafficRecorderRoundTrip(t *testing.T) {
+	for _, name := range []string{"traffic.ndjson", "traffic.pb"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), name)
+			recorder, recorderErr := NewTrafficRecorder(path)
+			if recorderErr != nil {
+				t.Fatalf("Not expected error from NewTrafficRecorder, error: %v", recorderErr)
+			}
+
+			move := &apiv1.TrafficRecord{
+				Transport: TransportTypeGRPCStr,
+				Request: &apiv1.TrafficRecord_MoveUnit{MoveUnit: &apiv1.MoveUnitRequest{
+					CargoUnitId:    7,
+					Location:       &apiv1.Location{Latitude: 1, Longitude: 2},
+					Sequence:       1,
+					IdempotencyKey: "move",
+				}},
+			}
+			reached := &apiv1.TrafficRecord{
+				Transport: TransportTypeGRPCStr,
+				Request: &apiv1.TrafficRecord_UnitReachedWarehouse{UnitReachedWarehouse: &apiv1.UnitReachedWarehouseRequest{
+					Announcement: &apiv1.WarehouseAnnouncement{CargoUnitId: 7, WarehouseId: 3},
+					Sequence:     2,
+				}},
+				Error: "unavailable",
+			}
+
+			// Clock starts at the earliest sent request, later request completes first and replay must still
+			// follow send order
+			start := time.Now()
+			recorder.Start(start.Add(time.Millisecond))
+			recorder.Start(start)
+			recorder.Start(start.Add(2 * time.Millisecond))
+			recorder.Record(start.Add(2*time.Millisecond), reached)
+			recorder.Record(start.Add(time.Millisecond), move)
+			if closeErr := recorder.Close(); closeErr != nil {
+				t.Fatalf("Not expected error from Close, error: %v", closeErr)
+			}
+
+			records, readErr := ReadTraffic(path)
+			if readErr != nil {
+				t.Fatalf("Not expected error from ReadTraffic, error: %v", readErr)
+			}
+			if len(records) != 2 {
+				t.Fatalf("Expected 2 records, but got %d", len(records))
+			}
+			if offset := records[0].GetOffset().AsDuration(); offset != time.Millisecond {
+				t.Errorf("Expected offset of first record from first sent request 1ms, but got %s", offset)
+			}
+			if !proto.Equal(records[0], move) {
+				t.Errorf("Expected first record %v, but got %v", move, records[0])
+			}
+			if !proto.Equal(records[1], reached) {
+				t.Errorf("Expected second record %v, but got %v", reached, records[1])
+			}
+		})
+	}
+}
+
+func TestTrafficRecorderNil(t *testing.T) {
+	var recorder *TrafficRecorder
+	recorder.Start(time.Now())
+	recorder.Record(time.Now(), &apiv1.TrafficRecord{})
+	if closeErr := recorder.Close(); closeErr != nil {
+		t.Errorf("Expected nil recorder to close without error, but got %v", closeErr)
+	}
+}
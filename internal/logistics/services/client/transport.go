@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+)
+
+// Names of built-in transports used in CLIENT_TRANSPORT_TYPE
+const (
+	TransportTypeGRPCStr       = "gRPC"
+	TransportTypeHTTPStr       = "HTTP"
+	TransportTypeGRPCStreamStr = "gRPCStream"
+	// TransportTypeHTTPJSONStr posts protojson bodies instead of query parameters.
+	TransportTypeHTTPJSONStr = "HTTPJSON"
+	// TransportTypeHTTPProtobufStr posts binary protobuf bodies.
+	TransportTypeHTTPProtobufStr = "HTTPProtobuf"
+
+	// DefaultTransport used when transport type is not configured.
+	DefaultTransport = TransportTypeGRPCStr
+)
+
+// Transport sends messages to API server over single protocol
+type Transport interface {
+	// Connect to server before any message is sent.
+	Connect(ctx context.Context, serverAddr string) error
+	MoveUnit(ctx context.Context, req *apiv1.MoveUnitRequest) error
+	UnitReachedWarehouse(ctx context.Context, req *apiv1.UnitReachedWarehouseRequest) error
+	// Close connection, safe to call when Connect was not called or failed.
+	Close() error
+}
+
+// ReceivedCountsReporter is implemented by transports that can ask server for GetReceivedCounts
+type ReceivedCountsReporter interface {
+	GetReceivedCounts(ctx context.Context, req *apiv1.GetReceivedCountsRequest) ([]*apiv1.CargoUnitReceivedCounts, error)
+}
+
+// MoveStreamer is implemented by transports that send moves over stream,
+// such moves are not retried and are acknowledged by CloseMoveStream.
+type MoveStreamer interface {
+	CloseMoveStream() error
+}
+
+// TransportOptions shared by all transports
+type TransportOptions struct {
+	// Scheme of HTTP based transports.
+	Scheme      string
+	TLSConfig   *tls.Config
+	Credentials *Credentials
+	// Dialer of connections to server instead of network, like in-memory listener of tests.
+	Dialer Dialer
+}
+
+// Dialer connects to server address
+type Dialer func(ctx context.Context, addr string) (net.Conn, error)
+
+// TransportFactory creates transport that is not connected yet
+type TransportFactory func(opts TransportOptions) (Transport, error)
+
+var (
+	transports     = make(map[string]TransportFactory)
+	transportsLock sync.RWMutex
+)
+
+// RegisterTransport factory by name used in CLIENT_TRANSPORT_TYPE, panics if name is already registered
+func RegisterTransport(name string, factory TransportFactory) {
+	transportsLock.Lock()
+	defer transportsLock.Unlock()
+
+	if _, ok := transports[name]; ok {
+		panic(fmt.Sprintf("transport %s is already registered", name))
+	}
+	transports[name] = factory
+}
+
+// NewTransport registered by name, DefaultTransport if name is empty
+func NewTransport(name string, opts TransportOptions) (Transport, error) {
+	if len(name) == 0 {
+		name = DefaultTransport
+	}
+
+	transportsLock.RLock()
+	factory, ok := transports[name]
+	transportsLock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown transport %q, registered: %s", name, strings.Join(RegisteredTransports(), ", "))
+	}
+
+	return factory(opts)
+}
+
+// RegisteredTransports names in alphabetical order
+func RegisteredTransports() []string {
+	transportsLock.RLock()
+	defer transportsLock.RUnlock()
+
+	names := make([]string, 0, len(transports))
+	for name := range transports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,101 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+func init() {
+	RegisterTransport(TransportTypeGRPCStr, newGRPCTransport)
+}
+
+// grpcTransport sends every message as unary gRPC call
+type grpcTransport struct {
+	opts TransportOptions
+
+	conn *grpc.ClientConn
+	api  apiv1.CoopLogisticsEngineAPIClient
+}
+
+func newGRPCTransport(opts TransportOptions) (Transport, error) {
+	return &grpcTransport{opts: opts}, nil
+}
+
+// Connect to gRPC API, over TLS and with credentials if they are configured
+func (t *grpcTransport) Connect(ctx context.Context, serverAddr string) error {
+	transportCredentials := insecure.NewCredentials()
+	if t.opts.TLSConfig != nil {
+		transportCredentials = credentials.NewTLS(t.opts.TLSConfig)
+	}
+
+	dialOptions := []grpc.DialOption{
+		grpc.WithTransportCredentials(transportCredentials),
+		grpc.WithBlock(),
+	}
+	if t.opts.Credentials != nil {
+		dialOptions = append(dialOptions, grpc.WithPerRPCCredentials(t.opts.Credentials))
+	}
+	if t.opts.Dialer != nil {
+		dialOptions = append(dialOptions, grpc.WithContextDialer(t.opts.Dialer))
+	}
+
+	conn, dialErr := grpc.DialContext(ctx, serverAddr, dialOptions...)
+	if dialErr != nil {
+		return dialErr
+	}
+
+	t.conn = conn
+	t.api = apiv1.NewCoopLogisticsEngineAPIClient(conn)
+
+	return nil
+}
+
+// MoveUnit as unary call
+func (t *grpcTransport) MoveUnit(ctx context.Context, req *apiv1.MoveUnitRequest) error {
+	if t.api == nil {
+		return ErrNotConnected
+	}
+
+	_, responseErr := t.api.MoveUnit(ctx, req)
+	return responseErr
+}
+
+// UnitReachedWarehouse as unary call
+func (t *grpcTransport) UnitReachedWarehouse(ctx context.Context, req *apiv1.UnitReachedWarehouseRequest) error {
+	if t.api == nil {
+		return ErrNotConnected
+	}
+
+	_, responseErr := t.api.UnitReachedWarehouse(ctx, req)
+	return responseErr
+}
+
+// GetReceivedCounts as unary call, ErrNotSupported if server does not implement it
+func (t *grpcTransport) GetReceivedCounts(ctx context.Context, req *apiv1.GetReceivedCountsRequest) ([]*apiv1.CargoUnitReceivedCounts, error) {
+	if t.api == nil {
+		return nil, ErrNotConnected
+	}
+
+	resp, responseErr := t.api.GetReceivedCounts(ctx, req)
+	if status.Code(responseErr) == codes.Unimplemented {
+		return nil, fmt.Errorf("GetReceivedCounts %w: %v", ErrNotSupported, responseErr)
+	}
+
+	return resp.GetCargoUnits(), responseErr
+}
+
+// Close gRPC connection
+func (t *grpcTransport) Close() error {
+	if t.conn == nil {
+		return nil
+	}
+
+	return t.conn.Close()
+}
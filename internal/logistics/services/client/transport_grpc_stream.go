@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+)
+
+func init() {
+	RegisterTransport(TransportTypeGRPCStreamStr, newGRPCStreamTransport)
+}
+
+// grpcStreamTransport sends all moves over single MoveUnits client stream, other messages as unary gRPC calls
+type grpcStreamTransport struct {
+	grpcTransport
+
+	moveStream apiv1.CoopLogisticsEngineAPI_MoveUnitsClient
+	// moveStreamCancel ends stream right away, so blocked Send returns
+	moveStreamCancel context.CancelFunc
+	moveStreamSent   uint64
+	moveStreamErr    error
+	// moveStreamSlot is held while stream is used, callers waiting for it can give up when their ctx is done
+	moveStreamSlot chan struct{}
+}
+
+func newGRPCStreamTransport(opts TransportOptions) (Transport, error) {
+	return &grpcStreamTransport{
+		grpcTransport:    grpcTransport{opts: opts},
+		moveStreamCancel: func() {},
+		moveStreamSlot:   make(chan struct{}, 1),
+	}, nil
+}
+
+// Connect to gRPC API and open MoveUnits stream that lives until Close
+func (t *grpcStreamTransport) Connect(ctx context.Context, serverAddr string) error {
+	if connErr := t.grpcTransport.Connect(ctx, serverAddr); connErr != nil {
+		return connErr
+	}
+
+	streamCtx, streamCtxCancel := context.WithCancel(context.Background())
+
+	stream, streamErr := t.api.MoveUnits(streamCtx)
+	if streamErr != nil {
+		streamCtxCancel()
+		return streamErr
+	}
+
+	t.moveStream = stream
+	t.moveStreamCancel = streamCtxCancel
+
+	return nil
+}
+
+// MoveUnit sent over stream, serialized since gRPC stream is not safe for concurrent SendMsg.
+// Send blocked by flow control is given up when ctx is done, which cancels the whole stream.
+func (t *grpcStreamTransport) MoveUnit(ctx context.Context, req *apiv1.MoveUnitRequest) error {
+	select {
+	case t.moveStreamSlot <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-t.moveStreamSlot }()
+
+	if t.moveStreamErr != nil {
+		return t.moveStreamErr
+	} else if t.moveStream == nil {
+		return ErrNotConnected
+	}
+
+	stopCancel := context.AfterFunc(ctx, t.moveStreamCancel)
+	sendErr := t.moveStream.Send(req)
+	if !stopCancel() {
+		t.moveStreamErr = fmt.Errorf("MoveUnits stream cancelled, error: %w", context.Cause(ctx))
+		if sendErr != nil {
+			return t.moveStreamErr
+		}
+	}
+
+	if sendErr != nil {
+		t.moveStreamErr = sendErr
+		if errors.Is(sendErr, io.EOF) {
+			// Server ended the stream, actual status is returned on receive
+			_, t.moveStreamErr = t.moveStream.CloseAndRecv()
+		}
+
+		return t.moveStreamErr
+	}
+
+	t.moveStreamSent++
+
+	return nil
+}
+
+// CloseMoveStream and verify that server accepted every sent move
+func (t *grpcStreamTransport) CloseMoveStream() error {
+	t.moveStreamSlot <- struct{}{}
+	defer func() { <-t.moveStreamSlot }()
+
+	if t.moveStream == nil {
+		return nil
+	}
+	defer t.moveStreamCancel()
+
+	stream := t.moveStream
+	t.moveStream = nil
+	if t.moveStreamErr != nil {
+		return fmt.Errorf("MoveUnits stream failed after %d sent moves, error: %w", t.moveStreamSent, t.moveStreamErr)
+	}
+	t.moveStreamErr = errors.New("MoveUnits stream is closed")
+
+	ack, closeErr := stream.CloseAndRecv()
+	if closeErr != nil {
+		return fmt.Errorf("MoveUnits stream was not acknowledged, error: %w", closeErr)
+	}
+
+	if ack.GetAcceptedCount()+ack.GetRejectedCount() != t.moveStreamSent {
+		return fmt.Errorf(
+			"MoveUnits stream dropped messages, sent: %d, accepted: %d, rejected: %d",
+			t.moveStreamSent,
+			ack.GetAcceptedCount(),
+			ack.GetRejectedCount(),
+		)
+	}
+	if ack.GetRejectedCount() > 0 {
+		return fmt.Errorf("MoveUnits stream rejected %d of %d sent moves", ack.GetRejectedCount(), t.moveStreamSent)
+	}
+
+	return nil
+}
+
+// Close stream, verifying it was acknowledged, and gRPC connection. Stream with move being sent is cancelled,
+// so send blocked by flow control does not block Close.
+func (t *grpcStreamTransport) Close() error {
+	select {
+	case t.moveStreamSlot <- struct{}{}:
+		<-t.moveStreamSlot
+	default:
+		t.moveStreamCancel()
+	}
+
+	return errors.Join(t.CloseMoveStream(), t.grpcTransport.Close())
+}
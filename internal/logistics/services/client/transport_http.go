@@ -0,0 +1,189 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+	"github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1/openapi"
+)
+
+func init() {
+	RegisterTransport(TransportTypeHTTPStr, newHTTPTransport)
+}
+
+// httpTransport sends messages as HTTP requests to grpc-gateway mapping of API
+type httpTransport struct {
+	opts TransportOptions
+
+	httpClient *http.Client
+	api        *openapi.APIClient
+}
+
+func newHTTPTransport(opts TransportOptions) (Transport, error) {
+	return &httpTransport{opts: opts}, nil
+}
+
+// Connect prepares HTTP client
+func (t *httpTransport) Connect(_ context.Context, serverAddr string) error {
+	httpConfig := openapi.NewConfiguration()
+	httpConfig.Host = serverAddr
+	httpConfig.Scheme = httpScheme(t.opts)
+
+	t.httpClient = newHTTPClient(t.opts)
+	httpConfig.HTTPClient = t.httpClient
+	t.api = openapi.NewAPIClient(httpConfig)
+
+	return nil
+}
+
+// MoveUnit as POST request
+func (t *httpTransport) MoveUnit(ctx context.Context, req *apiv1.MoveUnitRequest) error {
+	if t.api == nil {
+		return ErrNotConnected
+	}
+
+	_, httpResp, responseErr := t.api.CoopLogisticsEngineAPIAPI.
+		CoopLogisticsEngineAPIMoveUnit(ctx).
+		CargoUnitId(strconv.FormatInt(req.GetCargoUnitId(), 10)).
+		LocationLatitude(int64(req.GetLocation().GetLatitude())).
+		LocationLongitude(int64(req.GetLocation().GetLongitude())).
+		Sequence(strconv.FormatUint(req.GetSequence(), 10)).
+		EventTime(req.GetEventTime().AsTime()).
+		IdempotencyKey(req.GetIdempotencyKey()).
+		RunId(req.GetRunId()).
+		Execute()
+
+	return withHTTPStatus(httpResp, responseErr)
+}
+
+// UnitReachedWarehouse as POST request
+func (t *httpTransport) UnitReachedWarehouse(ctx context.Context, req *apiv1.UnitReachedWarehouseRequest) error {
+	if t.api == nil {
+		return ErrNotConnected
+	}
+
+	_, httpResp, responseErr := t.api.CoopLogisticsEngineAPIAPI.
+		CoopLogisticsEngineAPIUnitReachedWarehouse(ctx).
+		AnnouncementCargoUnitId(strconv.FormatInt(req.GetAnnouncement().GetCargoUnitId(), 10)).
+		AnnouncementWarehouseId(strconv.FormatInt(req.GetAnnouncement().GetWarehouseId(), 10)).
+		AnnouncementMessage(req.GetAnnouncement().GetMessage()).
+		LocationLatitude(int64(req.GetLocation().GetLatitude())).
+		LocationLongitude(int64(req.GetLocation().GetLongitude())).
+		Sequence(strconv.FormatUint(req.GetSequence(), 10)).
+		EventTime(req.GetEventTime().AsTime()).
+		IdempotencyKey(req.GetIdempotencyKey()).
+		RunId(req.GetRunId()).
+		Execute()
+
+	return withHTTPStatus(httpResp, responseErr)
+}
+
+// GetReceivedCounts as GET request, ErrNotSupported if server does not implement it
+func (t *httpTransport) GetReceivedCounts(ctx context.Context, req *apiv1.GetReceivedCountsRequest) ([]*apiv1.CargoUnitReceivedCounts, error) {
+	if t.api == nil {
+		return nil, ErrNotConnected
+	}
+
+	resp, httpResp, responseErr := t.api.CoopLogisticsEngineAPIAPI.
+		CoopLogisticsEngineAPIGetReceivedCounts(ctx).
+		RunId(req.GetRunId()).
+		Execute()
+	if httpResp != nil && isNotSupportedHTTPStatus(httpResp.StatusCode) {
+		return nil, fmt.Errorf("GetReceivedCounts %w: %s", ErrNotSupported, httpResp.Status)
+	}
+	if responseErr != nil {
+		return nil, responseErr
+	}
+
+	counts := make([]*apiv1.CargoUnitReceivedCounts, 0, len(resp.GetCargoUnits()))
+	for _, unit := range resp.GetCargoUnits() {
+		cargoUnitID, idErr := strconv.ParseInt(unit.GetCargoUnitId(), 10, 64)
+		moveUnitCount, moveErr := parseCount(unit.GetMoveUnitCount())
+		reachedCount, reachedErr := parseCount(unit.GetUnitReachedWarehouseCount())
+		outOfOrderCount, outOfOrderErr := parseCount(unit.GetOutOfOrderCount())
+		duplicateCount, duplicateErr := parseCount(unit.GetDuplicateCount())
+		if parseErr := errors.Join(idErr, moveErr, reachedErr, outOfOrderErr, duplicateErr); parseErr != nil {
+			return nil, fmt.Errorf("invalid GetReceivedCounts response, error: %w", parseErr)
+		}
+
+		counts = append(counts, &apiv1.CargoUnitReceivedCounts{
+			CargoUnitId:               cargoUnitID,
+			MoveUnitCount:             moveUnitCount,
+			UnitReachedWarehouseCount: reachedCount,
+			OutOfOrderCount:           outOfOrderCount,
+			DuplicateCount:            duplicateCount,
+		})
+	}
+
+	return counts, nil
+}
+
+// Close idle connections of HTTP client
+func (t *httpTransport) Close() error {
+	if t.httpClient != nil {
+		t.httpClient.CloseIdleConnections()
+	}
+
+	return nil
+}
+
+// newHTTPClient with TLS, credentials and dialer from options
+func newHTTPClient(opts TransportOptions) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if opts.TLSConfig != nil {
+		transport.TLSClientConfig = opts.TLSConfig
+	}
+	if opts.Dialer != nil {
+		transport.DialContext = func(ctx context.Context, _, addr string) (net.Conn, error) {
+			return opts.Dialer(ctx, addr)
+		}
+	}
+
+	var roundTripper http.RoundTripper = transport
+	if opts.Credentials != nil {
+		roundTripper = opts.Credentials.RoundTripper(transport)
+	}
+
+	return &http.Client{Transport: roundTripper}
+}
+
+// httpScheme from options, with TLS the default scheme is https
+func httpScheme(opts TransportOptions) string {
+	if len(opts.Scheme) > 0 {
+		return opts.Scheme
+	}
+	if opts.TLSConfig != nil {
+		return "https"
+	}
+
+	return "http"
+}
+
+// parseCount of uint64 that JSON mapping of protobuf encodes as string, empty means zero
+func parseCount(value string) (uint64, error) {
+	if len(value) == 0 {
+		return 0, nil
+	}
+
+	return strconv.ParseUint(value, 10, 64)
+}
+
+// withHTTPStatus of failed response, so retry policy can check it
+func withHTTPStatus(httpResp *http.Response, err error) error {
+	if err == nil || httpResp == nil {
+		return err
+	}
+
+	return &httpStatusError{statusCode: httpResp.StatusCode, err: err}
+}
+
+func isNotSupportedHTTPStatus(statusCode int) bool {
+	return statusCode == http.StatusNotFound ||
+		statusCode == http.StatusMethodNotAllowed ||
+		statusCode == http.StatusNotImplemented
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	mimeJSON     = "application/json"
+	mimeProtobuf = "application/x-protobuf"
+)
+
+func init() {
+	RegisterTransport(TransportTypeHTTPJSONStr, func(opts TransportOptions) (Transport, error) {
+		return &httpBodyTransport{opts: opts, contentType: mimeJSON}, nil
+	})
+	RegisterTransport(TransportTypeHTTPProtobufStr, func(opts TransportOptions) (Transport, error) {
+		return &httpBodyTransport{opts: opts, contentType: mimeProtobuf}, nil
+	})
+}
+
+// httpBodyTransport posts requests as protojson or binary protobuf bodies to body bindings of HTTP mapping
+type httpBodyTransport struct {
+	opts        TransportOptions
+	contentType string
+
+	baseURL    string
+	httpClient *http.Client
+}
+
+// Connect prepares HTTP client
+func (t *httpBodyTransport) Connect(_ context.Context, serverAddr string) error {
+	t.baseURL = (&url.URL{Scheme: httpScheme(t.opts), Host: serverAddr}).String()
+	t.httpClient = newHTTPClient(t.opts)
+
+	return nil
+}
+
+// MoveUnit as POST request with body
+func (t *httpBodyTransport) MoveUnit(ctx context.Context, req *apiv1.MoveUnitRequest) error {
+	return t.do(ctx, http.MethodPost, "/v1/cargo_unit:move", req, &apiv1.DefaultResponse{})
+}
+
+// UnitReachedWarehouse as POST request with body
+func (t *httpBodyTransport) UnitReachedWarehouse(ctx context.Context, req *apiv1.UnitReachedWarehouseRequest) error {
+	return t.do(ctx, http.MethodPost, "/v1/warehouse/cargo_unit:reached", req, &apiv1.DefaultResponse{})
+}
+
+// GetReceivedCounts as GET request, ErrNotSupported if server does not implement it
+func (t *httpBodyTransport) GetReceivedCounts(ctx context.Context, req *apiv1.GetReceivedCountsRequest) ([]*apiv1.CargoUnitReceivedCounts, error) {
+	resp := &apiv1.GetReceivedCountsResponse{}
+	path := "/v1/cargo_unit/received_counts?" + url.Values{"runId": {req.GetRunId()}}.Encode()
+	requestErr := t.do(ctx, http.MethodGet, path, nil, resp)
+
+	var statusErr *httpStatusError
+	if errors.As(requestErr, &statusErr) && isNotSupportedHTTPStatus(statusErr.statusCode) {
+		return nil, fmt.Errorf("GetReceivedCounts %w: %v", ErrNotSupported, requestErr)
+	}
+
+	return resp.GetCargoUnits(), requestErr
+}
+
+// Close idle connections of HTTP client
+func (t *httpBodyTransport) Close() error {
+	if t.httpClient != nil {
+		t.httpClient.CloseIdleConnections()
+	}
+
+	return nil
+}
+
+// do request with encoded body and decode response, body is not sent if nil
+func (t *httpBodyTransport) do(ctx context.Context, method, path string, body, response proto.Message) error {
+	if t.httpClient == nil {
+		return ErrNotConnected
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		encoded, encodeErr := t.marshal(body)
+		if encodeErr != nil {
+			return encodeErr
+		}
+		reqBody = bytes.NewReader(encoded)
+	}
+
+	httpReq, reqErr := http.NewRequestWithContext(ctx, method, t.baseURL+path, reqBody)
+	if reqErr != nil {
+		return reqErr
+	}
+	if body != nil {
+		httpReq.Header.Set("Content-Type", t.contentType)
+	}
+	httpReq.Header.Set("Accept", t.contentType)
+
+	httpResp, doErr := t.httpClient.Do(httpReq)
+	if doErr != nil {
+		return doErr
+	}
+	defer httpResp.Body.Close()
+
+	respBody, readErr := io.ReadAll(httpResp.Body)
+	if readErr != nil {
+		return readErr
+	}
+	if httpResp.StatusCode < 200 || httpResp.StatusCode >= 300 {
+		return &httpStatusError{
+			statusCode: httpResp.StatusCode,
+			err:        fmt.Errorf("%s %s failed with %s: %s", method, path, httpResp.Status, bytes.TrimSpace(respBody)),
+		}
+	}
+
+	return t.unmarshal(httpResp.Header.Get("Content-Type"), respBody, response)
+}
+
+func (t *httpBodyTransport) marshal(m proto.Message) ([]byte, error) {
+	if t.contentType == mimeProtobuf {
+		return proto.Marshal(m)
+	}
+
+	return protojson.Marshal(m)
+}
+
+// unmarshal response by its content type, server may answer with JSON even if protobuf was accepted
+func (t *httpBodyTransport) unmarshal(contentType string, data []byte, m proto.Message) error {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType == mimeJSON || len(mediaType) == 0 && t.contentType == mimeJSON {
+		return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, m)
+	}
+
+	return proto.Unmarshal(data, m)
+}
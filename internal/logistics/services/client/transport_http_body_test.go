@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/server"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/store"
+)
+
+// newTestGateway serving reference server over HTTP mapping, the same way logistics-server does
+func newTestGateway(t *testing.T) *httptest.Server {
+	ls := server.NewLogisticsServer(store.NewMemoryStore(), server.NewMovementBroker(), server.NewUnitCounter(), server.NewIdempotencyCache())
+
+	mux := server.NewGatewayMux()
+	if err := apiv1.RegisterCoopLogisticsEngineAPIHandlerServer(context.Background(), mux, ls); err != nil {
+		t.Fatalf("Not expected error registering gateway, error: %v", err)
+	}
+
+	return httptest.NewServer(mux)
+}
+
+func TestHTTPTransportsSendRequests(t *testing.T) {
+	for _, transportType := range []string{TransportTypeHTTPStr, TransportTypeHTTPJSONStr, TransportTypeHTTPProtobufStr} {
+		t.Run(transportType, func(t *testing.T) {
+			gateway := newTestGateway(t)
+			defer gateway.Close()
+
+			lc, clientErr := NewLogisticsClient(&config.ClientAppConfig{
+				TransportTypeProtocol: transportType,
+				Retry:                 config.ClientRetryConfig{MaxAttempts: 1},
+			})
+			if clientErr != nil {
+				t.Fatalf("Not expected error from NewLogisticsClient, error: %v", clientErr)
+			}
+			ctx := context.Background()
+			if err := lc.Connect(gateway.Listener.Addr().String(), ctx); err != nil {
+				t.Fatalf("Not expected error from Connect, error: %v", err)
+			}
+			defer func() { _ = lc.Disconnect() }()
+
+			location := &apiv1.Location{Latitude: 3, Longitude: 4}
+			for sequence := uint64(1); sequence <= 2; sequence++ {
+				err := lc.MoveUnit(ctx, &apiv1.MoveUnitRequest{CargoUnitId: 7, Location: location, Sequence: sequence})
+				if err != nil {
+					t.Errorf("Not expected error from MoveUnit, error: %v", err)
+				}
+			}
+			err := lc.UnitReachedWarehouse(ctx, &apiv1.UnitReachedWarehouseRequest{
+				Location:     location,
+				Announcement: &apiv1.WarehouseAnnouncement{CargoUnitId: 7, WarehouseId: 9, Message: "reached"},
+				Sequence:     2,
+			})
+			if err != nil {
+				t.Errorf("Not expected error from UnitReachedWarehouse, error: %v", err)
+			}
+
+			counts, countsErr := lc.GetReceivedCounts(ctx)
+			if countsErr != nil {
+				t.Fatalf("Not expected error from GetReceivedCounts, error: %v", countsErr)
+			}
+			if len(counts) != 1 {
+				t.Fatalf("Expected counts of single cargo unit, but got %v", counts)
+			}
+			unit := counts[0]
+			if unit.GetCargoUnitId() != 7 || unit.GetMoveUnitCount() != 2 || unit.GetUnitReachedWarehouseCount() != 1 {
+				t.Errorf("Expected 2 moves and 1 announcement of cargo unit 7, but got %v", unit)
+			}
+			if unit.GetDuplicateCount() != 1 {
+				t.Errorf("Expected sequence to be delivered and reused sequence reported as duplicate, but got %v", unit)
+			}
+		})
+	}
+}
+
+func TestHTTPBodyTransportReportsStatus(t *testing.T) {
+	gateway := newTestGateway(t)
+	defer gateway.Close()
+
+	lc, _ := NewLogisticsClient(&config.ClientAppConfig{
+		TransportTypeProtocol: TransportTypeHTTPProtobufStr,
+		Retry:                 config.ClientRetryConfig{MaxAttempts: 1},
+	})
+	_ = lc.Connect(gateway.Listener.Addr().String(), context.Background())
+
+	err := lc.MoveUnit(context.Background(), &apiv1.MoveUnitRequest{CargoUnitId: 1})
+	var statusErr *httpStatusError
+	if !errors.As(err, &statusErr) || statusErr.statusCode != http.StatusBadRequest {
+		t.Errorf("Expected %d status error for move without location, but got %v", http.StatusBadRequest, err)
+	}
+}
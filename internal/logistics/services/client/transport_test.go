@@ -0,0 +1,176 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testTransportName = "test"
+
+// testTransport records messages and fails MoveUnit with failures errors first
+type testTransport struct {
+	failures []error
+	moves    []*apiv1.MoveUnitRequest
+	closed   bool
+}
+
+func (t *testTransport) Connect(context.Context, string) error { return nil }
+
+func (t *testTransport) MoveUnit(_ context.Context, req *apiv1.MoveUnitRequest) error {
+	t.moves = append(t.moves, req)
+	if len(t.failures) > 0 {
+		err := t.failures[0]
+		t.failures = t.failures[1:]
+		return err
+	}
+
+	return nil
+}
+
+func (t *testTransport) UnitReachedWarehouse(context.Context, *apiv1.UnitReachedWarehouseRequest) error {
+	return nil
+}
+
+func (t *testTransport) Close() error {
+	t.closed = true
+	return nil
+}
+
+// testStreamTransport sends moves over stream, so they must not be retried
+type testStreamTransport struct {
+	testTransport
+}
+
+func (t *testStreamTransport) CloseMoveStream() error { return nil }
+
+var lastTestTransport Transport
+
+func init() {
+	RegisterTransport(testTransportName, func(opts TransportOptions) (Transport, error) {
+		if opts.Scheme == "stream" {
+			lastTestTransport = &testStreamTransport{testTransport{failures: []error{status.Error(codes.Unavailable, "")}}}
+		} else {
+			lastTestTransport = &testTransport{failures: []error{status.Error(codes.Unavailable, "")}}
+		}
+
+		return lastTestTransport, nil
+	})
+}
+
+func newTestTransportClient(t *testing.T, transportType, scheme string) *APILogisticsClient {
+	lc, clientErr := NewLogisticsClient(&config.ClientAppConfig{
+		TransportTypeProtocol: transportType,
+		Scheme:                scheme,
+		Retry:                 config.ClientRetryConfig{MaxAttempts: 3, GRPCCodes: []string{"Unavailable"}},
+	})
+	if clientErr != nil {
+		t.Fatalf("Not expected error from NewLogisticsClient, error: %v", clientErr)
+	}
+
+	return lc
+}
+
+func TestRegisteredTransports(t *testing.T) {
+	registered := map[string]bool{}
+	for _, name := range RegisteredTransports() {
+		registered[name] = true
+	}
+
+	for _, name := range []string{TransportTypeGRPCStr, TransportTypeGRPCStreamStr, TransportTypeHTTPStr, testTransportName} {
+		if !registered[name] {
+			t.Errorf("Expected transport %s to be registered, but got %v", name, RegisteredTransports())
+		}
+	}
+
+	if _, err := NewTransport("", TransportOptions{}); err != nil {
+		t.Errorf("Expected default transport for empty name, but got %v", err)
+	}
+	if _, err := NewTransport("carrier pigeon", TransportOptions{}); err == nil {
+		t.Errorf("Expected error for unknown transport")
+	}
+}
+
+func TestAPILogisticsClientUsesRegisteredTransport(t *testing.T) {
+	lc := newTestTransportClient(t, testTransportName, "")
+	transport := lastTestTransport.(*testTransport)
+
+	if err := lc.MoveUnit(context.Background(), &apiv1.MoveUnitRequest{CargoUnitId: 1}); err != nil {
+		t.Errorf("Not expected error from MoveUnit, error: %v", err)
+	}
+	if len(transport.moves) != 2 || lc.Retries() != 1 {
+		t.Fatalf("Expected failed move to be retried once, but got %d moves and %d retries", len(transport.moves), lc.Retries())
+	}
+	if key := transport.moves[0].GetIdempotencyKey(); len(key) == 0 || key != transport.moves[1].GetIdempotencyKey() {
+		t.Errorf("Expected retry to keep idempotency key, but got %q and %q", key, transport.moves[1].GetIdempotencyKey())
+	}
+
+	if _, err := lc.GetReceivedCounts(context.Background()); !errors.Is(err, ErrNotSupported) {
+		t.Errorf("Expected %v for transport without received counts, but got %v", ErrNotSupported, err)
+	}
+
+	if err := lc.Disconnect(); err != nil || !transport.closed {
+		t.Errorf("Expected transport to be closed, error: %v", err)
+	}
+}
+
+func TestAPILogisticsClientDoesNotRetryStreamedMoves(t *testing.T) {
+	lc := newTestTransportClient(t, testTransportName, "stream")
+	transport := lastTestTransport.(*testStreamTransport)
+
+	if err := lc.MoveUnit(context.Background(), &apiv1.MoveUnitRequest{CargoUnitId: 1}); err == nil {
+		t.Errorf("Expected stream error to be returned")
+	}
+	if len(transport.moves) != 1 || lc.Retries() != 0 {
+		t.Errorf("Expected streamed move not to be retried, but got %d moves", len(transport.moves))
+	}
+}
+
+func TestAPILogisticsClientDisconnectWithoutConnect(t *testing.T) {
+	for _, name := range []string{TransportTypeGRPCStr, TransportTypeGRPCStreamStr, TransportTypeHTTPStr} {
+		if err := newTestTransportClient(t, name, "").Disconnect(); err != nil {
+			t.Errorf("Not expected error from %s Disconnect before Connect, error: %v", name, err)
+		}
+	}
+}
+
+func TestTransportsNotConnected(t *testing.T) {
+	for _, name := range []string{TransportTypeGRPCStr, TransportTypeGRPCStreamStr, TransportTypeHTTPStr, TransportTypeHTTPJSONStr, TransportTypeHTTPProtobufStr} {
+		transport, transportErr := NewTransport(name, TransportOptions{})
+		if transportErr != nil {
+			t.Fatalf("Not expected error from NewTransport %s, error: %v", name, transportErr)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		if err := transport.MoveUnit(ctx, &apiv1.MoveUnitRequest{CargoUnitId: 1}); !errors.Is(err, ErrNotConnected) {
+			t.Errorf("Expected %v from %s MoveUnit before Connect, but got %v", ErrNotConnected, name, err)
+		}
+		if err := transport.UnitReachedWarehouse(ctx, &apiv1.UnitReachedWarehouseRequest{}); !errors.Is(err, ErrNotConnected) {
+			t.Errorf("Expected %v from %s UnitReachedWarehouse before Connect, but got %v", ErrNotConnected, name, err)
+		}
+		cancel()
+	}
+}
+
+func TestGRPCStreamTransportAfterFailedConnect(t *testing.T) {
+	transport, _ := NewTransport(TransportTypeGRPCStreamStr, TransportOptions{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := transport.Connect(ctx, "127.0.0.1:1"); err == nil {
+		t.Fatalf("Expected error from Connect with cancelled context")
+	}
+
+	if err := transport.MoveUnit(context.Background(), &apiv1.MoveUnitRequest{CargoUnitId: 1}); !errors.Is(err, ErrNotConnected) {
+		t.Errorf("Expected %v from MoveUnit after failed Connect, but got %v", ErrNotConnected, err)
+	}
+	if err := transport.Close(); err != nil {
+		t.Errorf("Not expected error from Close after failed Connect, error: %v", err)
+	}
+}
@@ -2,36 +2,56 @@ package operator
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"math/rand"
 
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
 	"github.com/coopnorge/interview-backend/internal/logistics/model"
 	"github.com/coopnorge/interview-backend/internal/pkg/generator"
 	"github.com/google/wire"
 )
 
 // ServiceSetForOperator providers
-var ServiceSetForOperator = wire.NewSet(NewWorldOperator)
+var ServiceSetForOperator = wire.NewSet(NewWorldOperator, NewRandom)
 
 // WorldOperator that handles world and units movements
 type WorldOperator struct {
-	world *model.Graph
+	world  *model.Graph
+	random *rand.Rand
 }
 
-// NewWorldOperator instance
-func NewWorldOperator() *WorldOperator {
+// NewRandom source shared by world generation and simulation, seeded with cfg.Seed
+func NewRandom(cfg *config.ClientAppConfig) *rand.Rand {
+	return generator.NewRand(cfg.Seed)
+}
+
+// NewWorldOperator instance that populates world from random
+func NewWorldOperator(random *rand.Rand) *WorldOperator {
 	return &WorldOperator{
-		world: model.NewGraph(),
+		world:  model.NewGraph(),
+		random: random,
 	}
 }
 
-func (wo *WorldOperator) Populate(maxWarehouses, maxCargoUnits uint32) error {
+// Populate world of width and height with warehouses and cargo units connected to them
+func (wo *WorldOperator) Populate(maxWarehouses, maxCargoUnits, width, height uint32) error {
 	if uint64(maxWarehouses)+uint64(maxCargoUnits) >= 1<<32-1 {
 		return errors.New("world actor count overflow")
 	}
+	if width == 0 || height == 0 {
+		return errors.New("world size must not be zero")
+	}
+	if cells := uint64(width) * uint64(height); uint64(maxWarehouses) > cells || uint64(maxCargoUnits) > cells {
+		return fmt.Errorf("%d warehouses and %d cargo units do not fit on %dx%d map", maxWarehouses, maxCargoUnits, width, height)
+	}
 
-	generator.AddNewActors(model.Warehouses, wo.world, uint(maxWarehouses), 0)
-	generator.AddNewActors(model.CargoUnits, wo.world, uint(maxCargoUnits), uint(maxWarehouses))
+	if warehousesErr := generator.AddNewActors(wo.random, model.Warehouses, wo.world, uint(maxWarehouses), 0, int(width), int(height)); warehousesErr != nil {
+		return fmt.Errorf("failed to place warehouses, error: %w", warehousesErr)
+	}
+	if cargoUnitsErr := generator.AddNewActors(wo.random, model.CargoUnits, wo.world, uint(maxCargoUnits), uint(maxWarehouses), int(width), int(height)); cargoUnitsErr != nil {
+		return fmt.Errorf("failed to place cargo units, error: %w", cargoUnitsErr)
+	}
 
 	var warehouseIDs []uint
 	var deliveryUnitIDs []uint
@@ -48,11 +68,11 @@ func (wo *WorldOperator) Populate(maxWarehouses, maxCargoUnits uint32) error {
 			break
 		}
 
-		rand.Shuffle(len(deliveryUnitIDs), func(i, j int) {
+		wo.random.Shuffle(len(deliveryUnitIDs), func(i, j int) {
 			deliveryUnitIDs[i], deliveryUnitIDs[j] = deliveryUnitIDs[j], deliveryUnitIDs[i]
 		})
 
-		numDeliveryUnits := rand.Intn(len(deliveryUnitIDs)) + 1 // Random number of units to connect (at least 1)
+		numDeliveryUnits := wo.random.Intn(len(deliveryUnitIDs)) + 1 // Random number of units to connect (at least 1)
 		for i := 0; i < numDeliveryUnits; i++ {
 			unitID := deliveryUnitIDs[i]
 
@@ -65,7 +85,7 @@ func (wo *WorldOperator) Populate(maxWarehouses, maxCargoUnits uint32) error {
 	return nil
 }
 
-// GetDeliveryUnit from the world
+// GetDeliveryUnit copies from the world, their coordinates are not updated when units move
 func (wo *WorldOperator) GetDeliveryUnit() []*model.GraphNode {
 	return wo.world.GetNodesByType(model.CargoUnits)
 }
@@ -75,42 +95,74 @@ func (wo *WorldOperator) FindEntityByCoordinate(coordinate model.Coordinate, ent
 	return wo.world.FindNodesByLocation(coordinate, entityType)
 }
 
-// MoveDeliveryUnitToNearestWarehouse moves the given unit to the nearest connected warehouse based on their X and Y locations
-func (wo *WorldOperator) MoveDeliveryUnitToNearestWarehouse(unitID uint) model.Coordinate {
-	deliveryUnitNode := wo.world.GetNodeByID(unitID)
-	unitX := deliveryUnitNode.X
-	unitY := deliveryUnitNode.Y
+// MarkDelivered unit that reached warehouse
+func (wo *WorldOperator) MarkDelivered(unitID uint) {
+	wo.world.UpdateNode(unitID, func(node *model.GraphNode) {
+		node.Metadata = true // Unit reached Warehouse
+	})
+}
+
+// IsDelivered if unit was marked as reached warehouse
+func (wo *WorldOperator) IsDelivered(unitID uint) bool {
+	node := wo.world.GetNodeByID(unitID)
 
+	return node != nil && node.Metadata == true
+}
+
+// MoveDeliveryUnitToNearestWarehouse moves the given unit one step to the nearest connected warehouse based on their
+// X and Y locations and returns its new coordinate, arrived is true if unit was already there and did not move.
+// Concurrent moves of the same unit are applied one after another.
+func (wo *WorldOperator) MoveDeliveryUnitToNearestWarehouse(unitID uint) (coordinate model.Coordinate, arrived bool) {
+	// Warehouses do not move, so their copies stay valid while unit is moved
 	connectedWarehouses := wo.world.GetConnectedNodes(unitID, model.Warehouses)
+	if len(connectedWarehouses) == 0 {
+		// Unit without connection heads to the first warehouse
+		if warehouse := wo.world.GetNodeByID(0); warehouse != nil {
+			connectedWarehouses = append(connectedWarehouses, warehouse)
+		}
+	}
+
+	wo.world.UpdateNode(unitID, func(deliveryUnitNode *model.GraphNode) {
+		unitX := deliveryUnitNode.X
+		unitY := deliveryUnitNode.Y
 
-	// Initialize variables for tracking the nearest warehouse
-	minDistance := math.MaxFloat64
-	nearestWarehouseID := uint(0)
+		// Initialize variables for tracking the nearest warehouse
+		minDistance := math.MaxFloat64
+		var nearestWarehouse *model.GraphNode
 
-	for _, warehouseNode := range connectedWarehouses {
-		warehouseX := warehouseNode.X
-		warehouseY := warehouseNode.Y
+		for _, warehouseNode := range connectedWarehouses {
+			warehouseX := warehouseNode.X
+			warehouseY := warehouseNode.Y
 
-		distance := math.Sqrt(math.Pow(float64(unitX-warehouseX), 2) + math.Pow(float64(unitY-warehouseY), 2))
+			distance := math.Sqrt(math.Pow(float64(unitX-warehouseX), 2) + math.Pow(float64(unitY-warehouseY), 2))
 
-		// Update nearest warehouse if distance is smaller
-		if distance < minDistance {
-			minDistance = distance
-			nearestWarehouseID = warehouseNode.ID
+			// Update nearest warehouse if distance is smaller
+			if distance < minDistance {
+				minDistance = distance
+				nearestWarehouse = warehouseNode
+			}
+		}
+		if nearestWarehouse == nil {
+			coordinate = *deliveryUnitNode.Coordinate
+			return
 		}
-	}
 
-	// Move unit to goal
-	if unitX < wo.world.GetNodeByID(nearestWarehouseID).X {
-		deliveryUnitNode.X++
-	} else if unitX > wo.world.GetNodeByID(nearestWarehouseID).X {
-		deliveryUnitNode.X--
-	}
-	if unitY < wo.world.GetNodeByID(nearestWarehouseID).Y {
-		deliveryUnitNode.Y++
-	} else if unitY > wo.world.GetNodeByID(nearestWarehouseID).Y {
-		deliveryUnitNode.Y--
-	}
+		// Move unit to goal
+		if unitX < nearestWarehouse.X {
+			deliveryUnitNode.X++
+		} else if unitX > nearestWarehouse.X {
+			deliveryUnitNode.X--
+		}
+
+		if unitY < nearestWarehouse.Y {
+			deliveryUnitNode.Y++
+		} else if unitY > nearestWarehouse.Y {
+			deliveryUnitNode.Y--
+		}
+
+		coordinate = *deliveryUnitNode.Coordinate
+		arrived = coordinate == model.Coordinate{X: unitX, Y: unitY}
+	})
 
-	return model.Coordinate{X: deliveryUnitNode.X, Y: deliveryUnitNode.Y}
+	return coordinate, arrived
 }
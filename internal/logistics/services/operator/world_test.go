@@ -4,13 +4,14 @@ import (
 	"testing"
 
 	"github.com/coopnorge/interview-backend/internal/logistics/model"
+	"github.com/coopnorge/interview-backend/internal/pkg/generator"
 )
 
 func TestNewWorldOperator(t *testing.T) {
 	// Create a new WorldOperator instance
-	wOperator := NewWorldOperator()
+	wOperator := NewWorldOperator(generator.NewRand(1))
 
-	populationErr := wOperator.Populate(2, 2)
+	populationErr := wOperator.Populate(2, 2, 255, 255)
 	if populationErr != nil {
 		t.Errorf("Not expected error when populating NewWorldOperator instance, error: %v", populationErr)
 	}
@@ -39,10 +40,120 @@ func TestNewWorldOperator(t *testing.T) {
 }
 
 func TestNewWorldOperatorActorOverflow(t *testing.T) {
-	wOperator := NewWorldOperator()
+	wOperator := NewWorldOperator(generator.NewRand(1))
 
-	populationErr := wOperator.Populate(^uint32(0), ^uint32(0))
+	populationErr := wOperator.Populate(^uint32(0), ^uint32(0), 255, 255)
 	if populationErr == nil {
 		t.Errorf("Expected error, since sum of max will overflow uint32")
 	}
 }
+
+func TestNewWorldOperatorSameSeedSameWorld(t *testing.T) {
+	first := NewWorldOperator(generator.NewRand(42))
+	second := NewWorldOperator(generator.NewRand(42))
+
+	for _, wOperator := range []*WorldOperator{first, second} {
+		if populationErr := wOperator.Populate(5, 20, 255, 255); populationErr != nil {
+			t.Fatalf("Not expected error when populating NewWorldOperator instance, error: %v", populationErr)
+		}
+	}
+
+	for i, node := range first.world.Nodes {
+		other := second.world.Nodes[i]
+		if node.ID != other.ID || node.Name != other.Name || *node.Coordinate != *other.Coordinate {
+			t.Errorf("Expected node %d to be %s at %v, but got %s at %v", node.ID, node.Name, *node.Coordinate, other.Name, *other.Coordinate)
+		}
+	}
+
+	if len(first.world.Edges) != len(second.world.Edges) {
+		t.Fatalf("Expected %d edges, but got %d", len(first.world.Edges), len(second.world.Edges))
+	}
+	for i, edge := range first.world.Edges {
+		if edge != second.world.Edges[i] {
+			t.Errorf("Expected edge %v, but got %v", edge, second.world.Edges[i])
+		}
+	}
+}
+
+func TestNewWorldOperatorLargeMap(t *testing.T) {
+	wOperator := NewWorldOperator(generator.NewRand(1))
+
+	var width, height uint32 = 1 << 20, 1 << 31
+	if populationErr := wOperator.Populate(3, 50, width, height); populationErr != nil {
+		t.Fatalf("Not expected error when populating NewWorldOperator instance, error: %v", populationErr)
+	}
+
+	outsideUint8 := false
+	for _, node := range wOperator.world.Nodes {
+		if node.X < 0 || node.X >= int(width) || node.Y < 0 || node.Y >= int(height) {
+			t.Errorf("Node %d out of map: (%d, %d)", node.ID, node.X, node.Y)
+		}
+		outsideUint8 = outsideUint8 || node.X > 255 || node.Y > 255
+	}
+	if !outsideUint8 {
+		t.Errorf("Expected nodes to be placed beyond 255x255 grid")
+	}
+
+	// Units move by single step in large coordinates too
+	for _, unit := range wOperator.GetDeliveryUnit() {
+		coordinate := *unit.Coordinate
+		moved, _ := wOperator.MoveDeliveryUnitToNearestWarehouse(unit.ID)
+		if dx, dy := moved.X-coordinate.X, moved.Y-coordinate.Y; dx < -1 || dx > 1 || dy < -1 || dy > 1 {
+			t.Errorf("Expected unit %d to move by single step from %v, but got %v", unit.ID, coordinate, moved)
+		}
+	}
+}
+
+func TestNewWorldOperatorZeroSize(t *testing.T) {
+	wOperator := NewWorldOperator(generator.NewRand(1))
+
+	if populationErr := wOperator.Populate(2, 2, 0, 255); populationErr == nil {
+		t.Errorf("Expected error, since world width is zero")
+	}
+}
+
+func TestMoveDeliveryUnitToNearestWarehouse(t *testing.T) {
+	wOperator := NewWorldOperator(generator.NewRand(1))
+	if populationErr := wOperator.Populate(2, 10, 32, 32); populationErr != nil {
+		t.Fatalf("Not expected error when populating NewWorldOperator instance, error: %v", populationErr)
+	}
+
+	for _, unit := range wOperator.GetDeliveryUnit() {
+		for step := 0; ; step++ {
+			if step > 64 {
+				t.Fatalf("Expected unit %d to arrive within 64 steps", unit.ID)
+			}
+
+			coordinate, arrived := wOperator.MoveDeliveryUnitToNearestWarehouse(unit.ID)
+			if !arrived {
+				continue
+			}
+			if wOperator.FindEntityByCoordinate(coordinate, model.Warehouses) == nil {
+				t.Errorf("Expected unit %d to arrive at warehouse, but got %v", unit.ID, coordinate)
+			}
+			if again, stillArrived := wOperator.MoveDeliveryUnitToNearestWarehouse(unit.ID); again != coordinate || !stillArrived {
+				t.Errorf("Expected arrived unit %d to stay at %v, but got %v", unit.ID, coordinate, again)
+			}
+			break
+		}
+
+		if wOperator.IsDelivered(unit.ID) {
+			t.Errorf("Expected unit %d not to be delivered before it is marked", unit.ID)
+		}
+		wOperator.MarkDelivered(unit.ID)
+		if !wOperator.IsDelivered(unit.ID) {
+			t.Errorf("Expected unit %d to be delivered", unit.ID)
+		}
+	}
+}
+
+func TestNewWorldOperatorTooManyActorsForMap(t *testing.T) {
+	wOperator := NewWorldOperator(generator.NewRand(1))
+
+	if populationErr := wOperator.Populate(2, 17, 4, 4); populationErr == nil {
+		t.Errorf("Expected error, since 17 cargo units do not fit on 4x4 map")
+	}
+	if len(wOperator.world.Nodes) != 0 {
+		t.Errorf("Expected no nodes to be added, but got %d", len(wOperator.world.Nodes))
+	}
+}
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Authenticator of incoming gRPC and HTTP requests by bearer token or API key
+type Authenticator struct {
+	token        string
+	apiKey       string
+	apiKeyHeader string
+}
+
+// NewAuthenticator instance, accepts every request if neither token nor API key is configured
+func NewAuthenticator(cfg *config.ServerAppConfig) *Authenticator {
+	apiKeyHeader := cfg.AuthAPIKeyHeader
+	if len(apiKeyHeader) == 0 {
+		apiKeyHeader = config.DefaultAPIKeyHeader
+	}
+
+	return &Authenticator{token: cfg.AuthToken, apiKey: cfg.AuthAPIKey, apiKeyHeader: apiKeyHeader}
+}
+
+// Enabled if requests must carry credentials
+func (a *Authenticator) Enabled() bool {
+	return len(a.token) > 0 || len(a.apiKey) > 0
+}
+
+// UnaryInterceptor rejects unauthenticated unary calls
+func (a *Authenticator) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := a.authenticateContext(ctx); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor rejects unauthenticated streaming calls
+func (a *Authenticator) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := a.authenticateContext(stream.Context()); err != nil {
+			return err
+		}
+
+		return handler(srv, stream)
+	}
+}
+
+// HTTPHandler rejects unauthenticated HTTP requests before they reach next,
+// needed because in-process gateway calls server methods without gRPC interceptors.
+func (a *Authenticator) HTTPHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !a.authenticate(r.Header.Get("Authorization"), r.Header.Get(a.apiKeyHeader)) {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "missing or invalid credentials", http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (a *Authenticator) authenticateContext(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if !a.authenticate(firstValue(md, "authorization"), firstValue(md, strings.ToLower(a.apiKeyHeader))) {
+		return status.Error(codes.Unauthenticated, "missing or invalid credentials")
+	}
+
+	return nil
+}
+
+// authenticate by Authorization header value or API key, any of configured credentials is enough
+func (a *Authenticator) authenticate(authorization, apiKey string) bool {
+	if !a.Enabled() {
+		return true
+	}
+
+	if len(a.token) > 0 {
+		token, isBearer := strings.CutPrefix(authorization, "Bearer ")
+		if isBearer && subtle.ConstantTimeCompare([]byte(token), []byte(a.token)) == 1 {
+			return true
+		}
+	}
+
+	return len(a.apiKey) > 0 && subtle.ConstantTimeCompare([]byte(apiKey), []byte(a.apiKey)) == 1
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
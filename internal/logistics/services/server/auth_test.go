@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthenticatorUnaryInterceptor(t *testing.T) {
+	a := NewAuthenticator(&config.ServerAppConfig{AuthToken: "secret", AuthAPIKey: "key"})
+	interceptor := a.UnaryInterceptor()
+	handler := func(context.Context, any) (any, error) { return "ok", nil }
+
+	cases := []struct {
+		name string
+		md   metadata.MD
+		code codes.Code
+	}{
+		{name: "bearer token", md: metadata.Pairs("authorization", "Bearer secret"), code: codes.OK},
+		{name: "API key", md: metadata.Pairs("x-api-key", "key"), code: codes.OK},
+		{name: "wrong token", md: metadata.Pairs("authorization", "Bearer guess"), code: codes.Unauthenticated},
+		{name: "token without scheme", md: metadata.Pairs("authorization", "secret"), code: codes.Unauthenticated},
+		{name: "anonymous", md: metadata.MD{}, code: codes.Unauthenticated},
+	}
+	for _, c := range cases {
+		ctx := metadata.NewIncomingContext(context.Background(), c.md)
+		if _, err := interceptor(ctx, nil, nil, handler); status.Code(err) != c.code {
+			t.Errorf("%s: expected %s, but got %v", c.name, c.code, err)
+		}
+	}
+}
+
+func TestAuthenticatorDisabled(t *testing.T) {
+	a := NewAuthenticator(&config.ServerAppConfig{})
+
+	if a.Enabled() {
+		t.Errorf("Expected authentication to be disabled without credentials")
+	}
+	if _, err := a.UnaryInterceptor()(context.Background(), nil, nil, func(context.Context, any) (any, error) { return nil, nil }); err != nil {
+		t.Errorf("Expected anonymous call to be accepted, but got %v", err)
+	}
+}
+
+func TestAuthenticatorHTTPHandler(t *testing.T) {
+	a := NewAuthenticator(&config.ServerAppConfig{AuthAPIKey: "key", AuthAPIKeyHeader: "X-Custom-Key"})
+	handler := a.HTTPHandler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/cargo_unit/move", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected %d for anonymous request, but got %d", http.StatusUnauthorized, rec.Code)
+	}
+
+	req.Header.Set("X-Custom-Key", "key")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected %d for request with API key, but got %d", http.StatusOK, rec.Code)
+	}
+}
@@ -0,0 +1,16 @@
+package server
+
+import (
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// MIMEProtobuf content type of binary protobuf bodies
+const MIMEProtobuf = "application/x-protobuf"
+
+// NewGatewayMux for HTTP mapping of api, request bodies of routes with body binding are accepted as protojson or
+// binary protobuf selected by Content-Type, responses are encoded by Accept header the same way.
+func NewGatewayMux(opts ...runtime.ServeMuxOption) *runtime.ServeMux {
+	return runtime.NewServeMux(append([]runtime.ServeMuxOption{
+		runtime.WithMarshalerOption(MIMEProtobuf, &runtime.ProtoMarshaller{}),
+	}, opts...)...)
+}
@@ -0,0 +1,141 @@
+package server
+
+import (
+	"sync"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+)
+
+// subscriptionBufferSize of events that subscriber may lag behind before it is disconnected
+const subscriptionBufferSize = 1 << 12
+
+// MovementFilter selects events for subscriber, nil field means no filtering by it
+type MovementFilter struct {
+	CargoUnitID *int64
+	// WarehouseID matches only UnitReachedWarehouse events
+	WarehouseID *int64
+}
+
+// Match event with filter
+func (f MovementFilter) Match(event *apiv1.UnitMovementEvent) bool {
+	var cargoUnitID int64
+	var warehouseID *int64
+
+	switch {
+	case event.GetMoved() != nil:
+		cargoUnitID = event.GetMoved().GetCargoUnitId()
+	case event.GetReached() != nil:
+		cargoUnitID = event.GetReached().GetAnnouncement().GetCargoUnitId()
+		id := event.GetReached().GetAnnouncement().GetWarehouseId()
+		warehouseID = &id
+	default:
+		return false
+	}
+
+	if f.CargoUnitID != nil && *f.CargoUnitID != cargoUnitID {
+		return false
+	}
+	if f.WarehouseID != nil && (warehouseID == nil || *f.WarehouseID != *warehouseID) {
+		return false
+	}
+
+	return true
+}
+
+// Subscription to movement events
+type Subscription struct {
+	events chan *apiv1.UnitMovementEvent
+	filter MovementFilter
+	// lagged is set when subscriber was disconnected because it did not keep up with events
+	lagged bool
+}
+
+// Events channel, closed when subscription ends
+func (s *Subscription) Events() <-chan *apiv1.UnitMovementEvent {
+	return s.events
+}
+
+// Lagged reports if subscription ended because subscriber was too slow,
+// must be called only after Events channel is closed.
+func (s *Subscription) Lagged() bool {
+	return s.lagged
+}
+
+// MovementBroker fans out accepted movements to subscribers
+type MovementBroker struct {
+	subscriptions map[*Subscription]struct{}
+	closed        bool
+
+	sync.Mutex
+}
+
+// NewMovementBroker instance
+func NewMovementBroker() *MovementBroker {
+	return &MovementBroker{subscriptions: make(map[*Subscription]struct{})}
+}
+
+// Subscribe to events matching filter, subscription of closed broker ends immediately
+func (b *MovementBroker) Subscribe(filter MovementFilter) *Subscription {
+	b.Lock()
+	defer b.Unlock()
+
+	s := &Subscription{
+		events: make(chan *apiv1.UnitMovementEvent, subscriptionBufferSize),
+		filter: filter,
+	}
+	if b.closed {
+		close(s.events)
+		return s
+	}
+
+	b.subscriptions[s] = struct{}{}
+
+	return s
+}
+
+// Unsubscribe and close subscription events
+func (b *MovementBroker) Unsubscribe(s *Subscription) {
+	b.Lock()
+	defer b.Unlock()
+
+	b.remove(s)
+}
+
+// Publish event to all matching subscribers, subscribers with full buffer are disconnected
+func (b *MovementBroker) Publish(event *apiv1.UnitMovementEvent) {
+	b.Lock()
+	defer b.Unlock()
+
+	for s := range b.subscriptions {
+		if !s.filter.Match(event) {
+			continue
+		}
+
+		select {
+		case s.events <- event:
+		default:
+			s.lagged = true
+			b.remove(s)
+		}
+	}
+}
+
+// Close broker and end all subscriptions
+func (b *MovementBroker) Close() {
+	b.Lock()
+	defer b.Unlock()
+
+	b.closed = true
+	for s := range b.subscriptions {
+		b.remove(s)
+	}
+}
+
+func (b *MovementBroker) remove(s *Subscription) {
+	if _, ok := b.subscriptions[s]; !ok {
+		return
+	}
+
+	delete(b.subscriptions, s)
+	close(s.events)
+}
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"testing"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+)
+
+func movedEvent(cargoUnitID int64) *apiv1.UnitMovementEvent {
+	return &apiv1.UnitMovementEvent{Event: &apiv1.UnitMovementEvent_Moved{
+		Moved: &apiv1.MoveUnitRequest{CargoUnitId: cargoUnitID, Location: &apiv1.Location{}},
+	}}
+}
+
+func reachedEvent(cargoUnitID, warehouseID int64) *apiv1.UnitMovementEvent {
+	return &apiv1.UnitMovementEvent{Event: &apiv1.UnitMovementEvent_Reached{
+		Reached: &apiv1.UnitReachedWarehouseRequest{
+			Location:     &apiv1.Location{},
+			Announcement: &apiv1.WarehouseAnnouncement{CargoUnitId: cargoUnitID, WarehouseId: warehouseID},
+		},
+	}}
+}
+
+func TestMovementFilterMatch(t *testing.T) {
+	cargoUnitID, warehouseID := int64(1), int64(0)
+
+	tests := []struct {
+		name     string
+		filter   MovementFilter
+		event    *apiv1.UnitMovementEvent
+		expected bool
+	}{
+		{name: "no filter", filter: MovementFilter{}, event: movedEvent(2), expected: true},
+		{name: "cargo unit match", filter: MovementFilter{CargoUnitID: &cargoUnitID}, event: movedEvent(1), expected: true},
+		{name: "cargo unit mismatch", filter: MovementFilter{CargoUnitID: &cargoUnitID}, event: movedEvent(2), expected: false},
+		{name: "warehouse skips moves", filter: MovementFilter{WarehouseID: &warehouseID}, event: movedEvent(1), expected: false},
+		{name: "warehouse zero match", filter: MovementFilter{WarehouseID: &warehouseID}, event: reachedEvent(3, 0), expected: true},
+		{name: "warehouse mismatch", filter: MovementFilter{WarehouseID: &warehouseID}, event: reachedEvent(3, 4), expected: false},
+	}
+
+	for _, tt := range tests {
+		if actual := tt.filter.Match(tt.event); actual != tt.expected {
+			t.Errorf("%s: expected %t, but got %t", tt.name, tt.expected, actual)
+		}
+	}
+}
+
+func TestMovementBrokerDisconnectsLaggingSubscriber(t *testing.T) {
+	broker := NewMovementBroker()
+	subscription := broker.Subscribe(MovementFilter{})
+
+	for i := 0; i <= subscriptionBufferSize; i++ {
+		broker.Publish(movedEvent(int64(i)))
+	}
+
+	received := 0
+	for range subscription.Events() {
+		received++
+	}
+
+	if received != subscriptionBufferSize {
+		t.Errorf("Expected %d buffered events, but got %d", subscriptionBufferSize, received)
+	}
+	if !subscription.Lagged() {
+		t.Errorf("Expected subscription to be marked as lagged")
+	}
+}
+
+func TestMovementBrokerClose(t *testing.T) {
+	broker := NewMovementBroker()
+	subscription := broker.Subscribe(MovementFilter{})
+
+	broker.Close()
+	broker.Unsubscribe(subscription) // must not panic on closed subscription
+
+	if _, ok := <-subscription.Events(); ok {
+		t.Errorf("Expected subscription to be closed")
+	}
+	if subscription.Lagged() {
+		t.Errorf("Not expected subscription closed by broker to be lagged")
+	}
+	if _, ok := <-broker.Subscribe(MovementFilter{}).Events(); ok {
+		t.Errorf("Expected subscription of closed broker to be closed")
+	}
+}
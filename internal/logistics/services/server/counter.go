@@ -0,0 +1,158 @@
+package server
+
+import (
+	"sort"
+	"sync"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+)
+
+// maxMissingSequences remembered per cargo unit, older gaps are forgotten and late arrivals into them are
+// reported as duplicates
+const maxMissingSequences = 1 << 12
+
+// UnitCounter of accepted messages per cargo unit of client run, cargo unit IDs are reused by every run
+type UnitCounter struct {
+	units     map[unitKey]*apiv1.CargoUnitReceivedCounts
+	sequences map[unitKey]*sequenceTracker
+
+	outOfOrder uint64
+	duplicates uint64
+
+	sync.Mutex
+}
+
+// unitKey of cargo unit in client run
+type unitKey struct {
+	runID       string
+	cargoUnitID int64
+}
+
+// sequenceTracker of single cargo unit
+type sequenceTracker struct {
+	highest uint64
+	// missing sequences lower than highest that were not received yet
+	missing map[uint64]struct{}
+}
+
+// NewUnitCounter instance
+func NewUnitCounter() *UnitCounter {
+	return &UnitCounter{
+		units:     make(map[unitKey]*apiv1.CargoUnitReceivedCounts),
+		sequences: make(map[unitKey]*sequenceTracker),
+	}
+}
+
+// AddMoveUnit accepted for cargo unit of run with its sequence, zero sequence is not tracked
+func (c *UnitCounter) AddMoveUnit(runID string, cargoUnitID int64, sequence uint64) {
+	c.Lock()
+	defer c.Unlock()
+
+	key := unitKey{runID: runID, cargoUnitID: cargoUnitID}
+	counts := c.getOrCreate(key)
+	counts.MoveUnitCount++
+	c.trackSequence(key, counts, sequence)
+}
+
+// AddUnitReachedWarehouse accepted for cargo unit of run with its sequence, zero sequence is not tracked
+func (c *UnitCounter) AddUnitReachedWarehouse(runID string, cargoUnitID int64, sequence uint64) {
+	c.Lock()
+	defer c.Unlock()
+
+	key := unitKey{runID: runID, cargoUnitID: cargoUnitID}
+	counts := c.getOrCreate(key)
+	counts.UnitReachedWarehouseCount++
+	c.trackSequence(key, counts, sequence)
+}
+
+// SequenceAnomalies of all cargo units of every run, messages received out of order and duplicated
+func (c *UnitCounter) SequenceAnomalies() (outOfOrder, duplicates uint64) {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.outOfOrder, c.duplicates
+}
+
+// Snapshot of counts of run ordered by cargo unit ID
+func (c *UnitCounter) Snapshot(runID string) []*apiv1.CargoUnitReceivedCounts {
+	c.Lock()
+	defer c.Unlock()
+
+	var snapshot []*apiv1.CargoUnitReceivedCounts
+	for key, counts := range c.units {
+		if key.runID != runID {
+			continue
+		}
+		snapshot = append(snapshot, &apiv1.CargoUnitReceivedCounts{
+			CargoUnitId:               counts.GetCargoUnitId(),
+			MoveUnitCount:             counts.GetMoveUnitCount(),
+			UnitReachedWarehouseCount: counts.GetUnitReachedWarehouseCount(),
+			OutOfOrderCount:           counts.GetOutOfOrderCount(),
+			DuplicateCount:            counts.GetDuplicateCount(),
+		})
+	}
+	sort.Slice(snapshot, func(i, j int) bool { return snapshot[i].GetCargoUnitId() < snapshot[j].GetCargoUnitId() })
+
+	return snapshot
+}
+
+func (c *UnitCounter) getOrCreate(key unitKey) *apiv1.CargoUnitReceivedCounts {
+	counts, ok := c.units[key]
+	if !ok {
+		counts = &apiv1.CargoUnitReceivedCounts{CargoUnitId: key.cargoUnitID}
+		c.units[key] = counts
+	}
+
+	return counts
+}
+
+// trackSequence classifies message as in order, out of order or duplicate
+func (c *UnitCounter) trackSequence(key unitKey, counts *apiv1.CargoUnitReceivedCounts, sequence uint64) {
+	if sequence == 0 {
+		return
+	}
+
+	tracker, ok := c.sequences[key]
+	if !ok {
+		tracker = &sequenceTracker{missing: make(map[uint64]struct{})}
+		c.sequences[key] = tracker
+	}
+
+	if sequence > tracker.highest {
+		gapStart := tracker.highest + 1
+		if sequence-gapStart > maxMissingSequences {
+			gapStart = sequence - maxMissingSequences
+		}
+		for missing := gapStart; missing < sequence; missing++ {
+			tracker.missing[missing] = struct{}{}
+		}
+		tracker.highest = sequence
+		tracker.forgetOldest()
+
+		return
+	}
+
+	if _, ok := tracker.missing[sequence]; ok {
+		delete(tracker.missing, sequence)
+		counts.OutOfOrderCount++
+		c.outOfOrder++
+
+		return
+	}
+
+	counts.DuplicateCount++
+	c.duplicates++
+}
+
+// forgetOldest missing sequences that are further than maxMissingSequences behind highest
+func (t *sequenceTracker) forgetOldest() {
+	if len(t.missing) <= maxMissingSequences {
+		return
+	}
+
+	for missing := range t.missing {
+		if t.highest-missing > maxMissingSequences {
+			delete(t.missing, missing)
+		}
+	}
+}
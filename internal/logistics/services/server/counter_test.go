@@ -0,0 +1,70 @@
+package server
+
+import "testing"
+
+func TestUnitCounterTracksSequence(t *testing.T) {
+	c := NewUnitCounter()
+
+	// 3 arrives before 2, 2 arrives late, 3 arrives twice, zero is not tracked
+	for _, sequence := range []uint64{1, 3, 2, 3, 0, 0} {
+		c.AddMoveUnit("run", 1, sequence)
+	}
+	c.AddUnitReachedWarehouse("run", 1, 1)
+
+	counts := c.Snapshot("run")
+	if len(counts) != 1 {
+		t.Fatalf("Expected counts of single cargo unit, but got %v", counts)
+	}
+	if counts[0].GetMoveUnitCount() != 6 || counts[0].GetUnitReachedWarehouseCount() != 1 {
+		t.Errorf("Expected all messages to be counted, but got %v", counts[0])
+	}
+	if counts[0].GetOutOfOrderCount() != 1 {
+		t.Errorf("Expected 1 out of order message, but got %d", counts[0].GetOutOfOrderCount())
+	}
+	if counts[0].GetDuplicateCount() != 2 {
+		t.Errorf("Expected 2 duplicates, but got %d", counts[0].GetDuplicateCount())
+	}
+
+	outOfOrder, duplicates := c.SequenceAnomalies()
+	if outOfOrder != 1 || duplicates != 2 {
+		t.Errorf("Expected 1 out of order and 2 duplicates in total, but got %d and %d", outOfOrder, duplicates)
+	}
+}
+
+func TestUnitCounterForgetsOldGaps(t *testing.T) {
+	c := NewUnitCounter()
+
+	c.AddMoveUnit("run", 1, maxMissingSequences*3)
+	if missing := len(c.sequences[unitKey{runID: "run", cargoUnitID: 1}].missing); missing != maxMissingSequences {
+		t.Errorf("Expected %d remembered missing sequences, but got %d", maxMissingSequences, missing)
+	}
+
+	c.AddMoveUnit("run", 1, maxMissingSequences*3-1)
+	c.AddMoveUnit("run", 1, 1)
+	if outOfOrder, duplicates := c.SequenceAnomalies(); outOfOrder != 1 || duplicates != 1 {
+		t.Errorf("Expected 1 out of order and 1 duplicate, but got %d and %d", outOfOrder, duplicates)
+	}
+}
+
+func TestUnitCounterScopesRuns(t *testing.T) {
+	c := NewUnitCounter()
+
+	// Second run reuses cargo unit ID and starts its sequence again
+	for _, runID := range []string{"first", "second"} {
+		for sequence := uint64(1); sequence <= 3; sequence++ {
+			c.AddMoveUnit(runID, 1, sequence)
+		}
+	}
+
+	for _, runID := range []string{"first", "second"} {
+		if counts := c.Snapshot(runID); len(counts) != 1 || counts[0].GetMoveUnitCount() != 3 {
+			t.Errorf("Expected 3 moves counted in run %s, but got %v", runID, counts)
+		}
+	}
+	if counts := c.Snapshot("other"); len(counts) != 0 {
+		t.Errorf("Expected no counts of other run, but got %v", counts)
+	}
+	if outOfOrder, duplicates := c.SequenceAnomalies(); outOfOrder != 0 || duplicates != 0 {
+		t.Errorf("Expected no anomalies across runs, but got %d out of order and %d duplicates", outOfOrder, duplicates)
+	}
+}
@@ -0,0 +1,130 @@
+package server
+
+import (
+	"context"
+	"sync"
+)
+
+// idempotencyCacheSize of recently applied request keys remembered by server
+const idempotencyCacheSize = 1 << 16
+
+// IdempotencyCache of recently applied requests, so retried requests are acknowledged without applying them again.
+// Keys are scoped to client run, so replayed traffic with recorded keys is applied again in new run.
+type IdempotencyCache struct {
+	// keys of claimed requests, pending until request is committed
+	keys map[requestKey]*idempotencyEntry
+	// order of claimed keys, oldest one is replaced when cache is full
+	order []requestKey
+	next  int
+
+	sync.Mutex
+}
+
+// requestKey is idempotency key of request in client run
+type requestKey struct {
+	runID string
+	key   string
+}
+
+// idempotencyEntry of claimed key, done is closed when request is committed or released
+type idempotencyEntry struct {
+	slot      int
+	committed bool
+	done      chan struct{}
+}
+
+// IdempotencyClaim of request key returned by Begin, request must be finished with Commit or Release
+type IdempotencyClaim struct {
+	cache *IdempotencyCache
+	key   requestKey
+	// entry is nil for request without key, it is not remembered
+	entry *idempotencyEntry
+}
+
+// NewIdempotencyCache instance
+func NewIdempotencyCache() *IdempotencyCache {
+	return &IdempotencyCache{
+		keys:  make(map[requestKey]*idempotencyEntry),
+		order: make([]requestKey, 0, idempotencyCacheSize),
+	}
+}
+
+// Begin claims key of request in run before it is applied, nil claim if request with key was already committed in
+// the run. Request with the same key that is being applied right now is waited for until it is committed, or
+// released and claimed by this call, error if ctx is done first. Request without key is always claimed.
+func (c *IdempotencyCache) Begin(ctx context.Context, runID, idempotencyKey string) (*IdempotencyClaim, error) {
+	key := requestKey{runID: runID, key: idempotencyKey}
+	if len(idempotencyKey) == 0 {
+		return &IdempotencyClaim{cache: c, key: key}, nil
+	}
+
+	for {
+		c.Lock()
+		entry, ok := c.keys[key]
+		if !ok {
+			entry = c.claim(key)
+			c.Unlock()
+
+			return &IdempotencyClaim{cache: c, key: key, entry: entry}, nil
+		}
+		if entry.committed {
+			c.Unlock()
+			return nil, nil
+		}
+		c.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-entry.done:
+		}
+	}
+}
+
+// Commit applied request, its retries are acknowledged without applying them again
+func (cl *IdempotencyClaim) Commit() {
+	if cl.entry == nil {
+		return
+	}
+
+	cl.cache.Lock()
+	defer cl.cache.Unlock()
+
+	cl.entry.committed = true
+	close(cl.entry.done)
+}
+
+// Release key of request that failed to apply, so its retry is applied
+func (cl *IdempotencyClaim) Release() {
+	if cl.entry == nil {
+		return
+	}
+
+	cl.cache.Lock()
+	defer cl.cache.Unlock()
+
+	if cl.cache.keys[cl.key] == cl.entry {
+		delete(cl.cache.keys, cl.key)
+	}
+	close(cl.entry.done)
+}
+
+// claim key as pending, replaces oldest key when cache is full
+func (c *IdempotencyCache) claim(key requestKey) *idempotencyEntry {
+	slot := len(c.order)
+	if len(c.order) < cap(c.order) {
+		c.order = append(c.order, key)
+	} else {
+		slot = c.next
+		if oldest := c.order[slot]; c.keys[oldest] != nil && c.keys[oldest].slot == slot {
+			delete(c.keys, oldest)
+		}
+		c.order[slot] = key
+		c.next = (c.next + 1) % len(c.order)
+	}
+
+	entry := &idempotencyEntry{slot: slot, done: make(chan struct{})}
+	c.keys[key] = entry
+
+	return entry
+}
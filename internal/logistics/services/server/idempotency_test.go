@@ -0,0 +1,155 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// applied claims key and commits it, false if key was already committed
+func applied(t *testing.T, c *IdempotencyCache, runID, key string) bool {
+	t.Helper()
+
+	claim, err := c.Begin(context.Background(), runID, key)
+	if err != nil {
+		t.Fatalf("Not expected error from Begin, error: %v", err)
+	}
+	if claim == nil {
+		return false
+	}
+	claim.Commit()
+
+	return true
+}
+
+func TestIdempotencyCacheForgetsOldestKey(t *testing.T) {
+	c := NewIdempotencyCache()
+
+	if !applied(t, c, "run", "") || !applied(t, c, "run", "") {
+		t.Errorf("Expected empty key to be always claimed")
+	}
+	if len(c.keys) != 0 {
+		t.Errorf("Expected empty key to be ignored")
+	}
+
+	for i := 0; i <= idempotencyCacheSize; i++ {
+		applied(t, c, "run", strconv.Itoa(i))
+	}
+
+	if !applied(t, c, "run", "0") {
+		t.Errorf("Expected oldest key to be forgotten")
+	}
+	if applied(t, c, "run", strconv.Itoa(idempotencyCacheSize)) {
+		t.Errorf("Expected newer keys to be remembered")
+	}
+	if len(c.keys) != idempotencyCacheSize {
+		t.Errorf("Expected %d remembered keys, but got %d", idempotencyCacheSize, len(c.keys))
+	}
+}
+
+func TestIdempotencyCacheRelease(t *testing.T) {
+	c := NewIdempotencyCache()
+
+	claim, err := c.Begin(context.Background(), "run", "key")
+	if err != nil || claim == nil {
+		t.Fatalf("Expected key to be claimed, but got %v, error: %v", claim, err)
+	}
+	claim.Release()
+
+	if !applied(t, c, "run", "key") || applied(t, c, "run", "key") {
+		t.Errorf("Expected released key to be claimed again and then committed once")
+	}
+}
+
+func TestIdempotencyCacheConcurrentSameKey(t *testing.T) {
+	const requests = 64
+
+	c := NewIdempotencyCache()
+	var claimed atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if applied(t, c, "run", "key") {
+				claimed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if claimed.Load() != 1 {
+		t.Errorf("Expected key to be claimed once, but got %d", claimed.Load())
+	}
+}
+
+func TestIdempotencyCachePendingKeyWaitsForResult(t *testing.T) {
+	c := NewIdempotencyCache()
+
+	first, err := c.Begin(context.Background(), "run", "key")
+	if err != nil || first == nil {
+		t.Fatalf("Expected key to be claimed, but got %v, error: %v", first, err)
+	}
+
+	type result struct {
+		claim *IdempotencyClaim
+		err   error
+	}
+	retried := make(chan result, 1)
+	go func() {
+		claim, claimErr := c.Begin(context.Background(), "run", "key")
+		retried <- result{claim: claim, err: claimErr}
+	}()
+
+	select {
+	case <-retried:
+		t.Fatalf("Expected retry to wait while first attempt is pending")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	first.Release()
+	retry := <-retried
+	if retry.err != nil || retry.claim == nil {
+		t.Fatalf("Expected retry to claim key released by failed attempt, but got %v, error: %v", retry.claim, retry.err)
+	}
+
+	go func() {
+		claim, claimErr := c.Begin(context.Background(), "run", "key")
+		retried <- result{claim: claim, err: claimErr}
+	}()
+	retry.claim.Commit()
+	if again := <-retried; again.err != nil || again.claim != nil {
+		t.Errorf("Expected retry of committed request to be acknowledged, but got %v, error: %v", again.claim, again.err)
+	}
+}
+
+func TestIdempotencyCachePendingKeyCancelled(t *testing.T) {
+	c := NewIdempotencyCache()
+
+	if _, err := c.Begin(context.Background(), "run", "key"); err != nil {
+		t.Fatalf("Not expected error from Begin, error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	claim, err := c.Begin(ctx, "run", "key")
+	if !errors.Is(err, context.DeadlineExceeded) || claim != nil {
+		t.Errorf("Expected retry of pending request to end with its context, but got %v, error: %v", claim, err)
+	}
+}
+
+func TestIdempotencyCacheScopesRuns(t *testing.T) {
+	c := NewIdempotencyCache()
+
+	if !applied(t, c, "first", "key") || !applied(t, c, "second", "key") {
+		t.Errorf("Expected the same key to be claimed once in every run")
+	}
+	if applied(t, c, "second", "key") {
+		t.Errorf("Expected key to be remembered in its run")
+	}
+}
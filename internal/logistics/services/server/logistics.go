@@ -0,0 +1,240 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"sync/atomic"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+	"github.com/coopnorge/interview-backend/internal/logistics/model"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/store"
+	"github.com/google/wire"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ServiceSetForServer providers
+var ServiceSetForServer = wire.NewSet(NewLogisticsServer, NewMovementBroker, NewUnitCounter, NewIdempotencyCache, NewAuthenticator)
+
+// APILogisticsServer reference implementation of apiv1.CoopLogisticsEngineAPIServer
+type APILogisticsServer struct {
+	apiv1.UnimplementedCoopLogisticsEngineAPIServer
+
+	deliveryPaths store.DeliveryPathStore
+	movements     *MovementBroker
+	unitCounts    *UnitCounter
+	applied       *IdempotencyCache
+
+	// received messages since last SwapReceived call
+	received atomic.Uint64
+	// totalReceived messages since server start
+	totalReceived atomic.Uint64
+}
+
+// NewLogisticsServer instance
+func NewLogisticsServer(
+	deliveryPaths store.DeliveryPathStore,
+	movements *MovementBroker,
+	unitCounts *UnitCounter,
+	applied *IdempotencyCache,
+) *APILogisticsServer {
+	return &APILogisticsServer{deliveryPaths: deliveryPaths, movements: movements, unitCounts: unitCounts, applied: applied}
+}
+
+// MoveUnit accepts new location of cargo unit
+func (ls *APILogisticsServer) MoveUnit(ctx context.Context, req *apiv1.MoveUnitRequest) (*apiv1.DefaultResponse, error) {
+	if err := ls.acceptMove(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.DefaultResponse{}, nil
+}
+
+// MoveUnits accepts stream of cargo unit locations and acknowledges counts when client closes it
+func (ls *APILogisticsServer) MoveUnits(stream apiv1.CoopLogisticsEngineAPI_MoveUnitsServer) error {
+	response := &apiv1.MoveUnitsResponse{}
+
+	for {
+		req, recvErr := stream.Recv()
+		if errors.Is(recvErr, io.EOF) {
+			return stream.SendAndClose(response)
+		} else if recvErr != nil {
+			return recvErr
+		}
+
+		if err := ls.acceptMove(stream.Context(), req); err != nil {
+			response.RejectedCount++
+			continue
+		}
+
+		response.AcceptedCount++
+	}
+}
+
+// UnitReachedWarehouse accepts announcement that cargo unit reached warehouse
+func (ls *APILogisticsServer) UnitReachedWarehouse(ctx context.Context, req *apiv1.UnitReachedWarehouseRequest) (*apiv1.DefaultResponse, error) {
+	ls.countMessage()
+
+	if req.GetLocation() == nil {
+		return nil, status.Error(codes.InvalidArgument, "location is required")
+	}
+	if req.GetAnnouncement() == nil {
+		return nil, status.Error(codes.InvalidArgument, "announcement is required")
+	}
+	claim, claimErr := ls.applied.Begin(ctx, req.GetRunId(), req.GetIdempotencyKey())
+	if claimErr != nil {
+		return nil, status.FromContextError(claimErr).Err()
+	} else if claim == nil {
+		return &apiv1.DefaultResponse{}, nil
+	}
+
+	storeErr := ls.deliveryPaths.MarkReached(ctx, req.GetRunId(), req.GetAnnouncement().GetCargoUnitId(), req.GetAnnouncement().GetWarehouseId())
+	if storeErr != nil {
+		claim.Release()
+		return nil, status.Errorf(codes.Internal, "failed to store warehouse announcement, error: %v", storeErr)
+	}
+	claim.Commit()
+
+	ls.unitCounts.AddUnitReachedWarehouse(req.GetRunId(), req.GetAnnouncement().GetCargoUnitId(), req.GetSequence())
+
+	ls.movements.Publish(&apiv1.UnitMovementEvent{Event: &apiv1.UnitMovementEvent_Reached{Reached: req}})
+
+	return &apiv1.DefaultResponse{}, nil
+}
+
+// ExportWarehouseSuppliers returns each warehouse with cargo units that delivered to it, only of requested run if set
+func (ls *APILogisticsServer) ExportWarehouseSuppliers(ctx context.Context, req *apiv1.ExportWarehouseSuppliersRequest) (*apiv1.ExportWarehouseSuppliersResponse, error) {
+	paths, listErr := ls.deliveryPaths.List(ctx)
+	if listErr != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list delivery paths, error: %v", listErr)
+	}
+
+	return &apiv1.ExportWarehouseSuppliersResponse{Warehouses: groupSuppliersByWarehouse(paths, req.GetRunId())}, nil
+}
+
+// GetReceivedCounts of accepted messages per cargo unit of requested run since server start
+func (ls *APILogisticsServer) GetReceivedCounts(_ context.Context, req *apiv1.GetReceivedCountsRequest) (*apiv1.GetReceivedCountsResponse, error) {
+	return &apiv1.GetReceivedCountsResponse{CargoUnits: ls.unitCounts.Snapshot(req.GetRunId())}, nil
+}
+
+// WatchUnitMovements streams accepted requests until client cancels or server stops
+func (ls *APILogisticsServer) WatchUnitMovements(req *apiv1.WatchUnitMovementsRequest, stream apiv1.CoopLogisticsEngineAPI_WatchUnitMovementsServer) error {
+	subscription := ls.movements.Subscribe(MovementFilter{
+		CargoUnitID: req.CargoUnitId,
+		WarehouseID: req.WarehouseId,
+	})
+	defer ls.movements.Unsubscribe(subscription)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case event, ok := <-subscription.Events():
+			if !ok {
+				return subscriptionEndError(subscription)
+			}
+			if sendErr := stream.Send(event); sendErr != nil {
+				return sendErr
+			}
+		}
+	}
+}
+
+// CloseSubscriptions ends all WatchUnitMovements streams, used on shutdown
+func (ls *APILogisticsServer) CloseSubscriptions() {
+	ls.movements.Close()
+}
+
+// SwapReceived returns number of messages received since previous call and resets it
+func (ls *APILogisticsServer) SwapReceived() uint64 {
+	return ls.received.Swap(0)
+}
+
+// TotalReceived messages since server start
+func (ls *APILogisticsServer) TotalReceived() uint64 {
+	return ls.totalReceived.Load()
+}
+
+// SequenceAnomalies of accepted messages since server start, see UnitCounter.SequenceAnomalies
+func (ls *APILogisticsServer) SequenceAnomalies() (outOfOrder, duplicates uint64) {
+	return ls.unitCounts.SequenceAnomalies()
+}
+
+// acceptMove validates, stores and publishes cargo unit location, already applied retries are only acknowledged and
+// retries of request that is still being applied wait for its result
+func (ls *APILogisticsServer) acceptMove(ctx context.Context, req *apiv1.MoveUnitRequest) error {
+	ls.countMessage()
+
+	if req.GetLocation() == nil {
+		return status.Error(codes.InvalidArgument, "location is required")
+	}
+	claim, claimErr := ls.applied.Begin(ctx, req.GetRunId(), req.GetIdempotencyKey())
+	if claimErr != nil {
+		return status.FromContextError(claimErr).Err()
+	} else if claim == nil {
+		return nil
+	}
+
+	storeErr := ls.deliveryPaths.AppendLocation(ctx, req.GetRunId(), req.GetCargoUnitId(), locationToCoordinate(req.GetLocation()))
+	if storeErr != nil {
+		claim.Release()
+		return status.Errorf(codes.Internal, "failed to store location, error: %v", storeErr)
+	}
+	claim.Commit()
+
+	ls.unitCounts.AddMoveUnit(req.GetRunId(), req.GetCargoUnitId(), req.GetSequence())
+
+	ls.movements.Publish(&apiv1.UnitMovementEvent{Event: &apiv1.UnitMovementEvent_Moved{Moved: req}})
+
+	return nil
+}
+
+func (ls *APILogisticsServer) countMessage() {
+	ls.received.Add(1)
+	ls.totalReceived.Add(1)
+}
+
+func locationToCoordinate(location *apiv1.Location) model.Coordinate {
+	return model.Coordinate{X: int(location.GetLatitude()), Y: int(location.GetLongitude())}
+}
+
+// groupSuppliersByWarehouse that cargo units reached, ordered by warehouse ID, only cargo units of runID if set
+func groupSuppliersByWarehouse(paths []model.DeliveryPath, runID string) []*apiv1.WarehouseSuppliers {
+	warehouses := make(map[int64]*apiv1.WarehouseSuppliers)
+	for _, path := range paths {
+		if !path.Reached || len(runID) > 0 && path.RunID != runID {
+			continue
+		}
+
+		warehouse, ok := warehouses[path.WarehouseID]
+		if !ok {
+			warehouse = &apiv1.WarehouseSuppliers{WarehouseId: path.WarehouseID}
+			warehouses[path.WarehouseID] = warehouse
+		}
+
+		warehouse.DeliveryCount++
+		warehouse.Suppliers = append(warehouse.Suppliers, &apiv1.Supplier{
+			CargoUnitId: path.CargoUnitID,
+			PathLength:  uint32(len(path.Path)),
+			RunId:       path.RunID,
+		})
+	}
+
+	result := make([]*apiv1.WarehouseSuppliers, 0, len(warehouses))
+	for _, warehouse := range warehouses {
+		result = append(result, warehouse)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].GetWarehouseId() < result[j].GetWarehouseId() })
+
+	return result
+}
+
+func subscriptionEndError(subscription *Subscription) error {
+	if subscription.Lagged() {
+		return status.Error(codes.ResourceExhausted, "subscriber is too slow, events were not consumed in time")
+	}
+
+	return status.Error(codes.Unavailable, "server is shutting down")
+}
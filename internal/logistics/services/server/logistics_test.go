@@ -0,0 +1,267 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+	"github.com/coopnorge/interview-backend/internal/logistics/model"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAPILogisticsServerCountsMessages(t *testing.T) {
+	ls := NewLogisticsServer(store.NewMemoryStore(), NewMovementBroker(), NewUnitCounter(), NewIdempotencyCache())
+	ctx := context.Background()
+
+	location := &apiv1.Location{Latitude: 1, Longitude: 2}
+	if _, err := ls.MoveUnit(ctx, &apiv1.MoveUnitRequest{CargoUnitId: 1, Location: location}); err != nil {
+		t.Errorf("Not expected error from MoveUnit, error: %v", err)
+	}
+	_, err := ls.UnitReachedWarehouse(ctx, &apiv1.UnitReachedWarehouseRequest{
+		Location:     location,
+		Announcement: &apiv1.WarehouseAnnouncement{CargoUnitId: 1, WarehouseId: 2},
+	})
+	if err != nil {
+		t.Errorf("Not expected error from UnitReachedWarehouse, error: %v", err)
+	}
+
+	if received := ls.SwapReceived(); received != 2 {
+		t.Errorf("Expected 2 received messages, but got %d", received)
+	}
+	if received := ls.SwapReceived(); received != 0 {
+		t.Errorf("Expected received messages to be reset, but got %d", received)
+	}
+	if total := ls.TotalReceived(); total != 2 {
+		t.Errorf("Expected 2 total messages, but got %d", total)
+	}
+
+	counts, _ := ls.GetReceivedCounts(ctx, &apiv1.GetReceivedCountsRequest{})
+	if len(counts.GetCargoUnits()) != 1 {
+		t.Fatalf("Expected counts of single cargo unit, but got %v", counts.GetCargoUnits())
+	}
+	if unit := counts.GetCargoUnits()[0]; unit.GetMoveUnitCount() != 1 || unit.GetUnitReachedWarehouseCount() != 1 {
+		t.Errorf("Expected one accepted message of each kind, but got %v", unit)
+	}
+}
+
+func TestAPILogisticsServerRejectsMissingLocation(t *testing.T) {
+	ls := NewLogisticsServer(store.NewMemoryStore(), NewMovementBroker(), NewUnitCounter(), NewIdempotencyCache())
+
+	_, err := ls.MoveUnit(context.Background(), &apiv1.MoveUnitRequest{CargoUnitId: 1})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected %s, but got %v", codes.InvalidArgument, err)
+	}
+}
+
+func TestAPILogisticsServerExportWarehouseSuppliers(t *testing.T) {
+	ls := NewLogisticsServer(store.NewMemoryStore(), NewMovementBroker(), NewUnitCounter(), NewIdempotencyCache())
+	ctx := context.Background()
+
+	moves := []struct {
+		cargoUnitID int64
+		steps       int
+		warehouseID int64
+	}{
+		{cargoUnitID: 1, steps: 3, warehouseID: 20},
+		{cargoUnitID: 2, steps: 1, warehouseID: 10},
+		{cargoUnitID: 3, steps: 2, warehouseID: 20},
+		{cargoUnitID: 4, steps: 5}, // not reached any warehouse yet
+	}
+	for _, m := range moves {
+		for i := 0; i < m.steps; i++ {
+			_, _ = ls.MoveUnit(ctx, &apiv1.MoveUnitRequest{CargoUnitId: m.cargoUnitID, Location: &apiv1.Location{Latitude: uint32(i)}})
+		}
+		if m.warehouseID == 0 {
+			continue
+		}
+		_, _ = ls.UnitReachedWarehouse(ctx, &apiv1.UnitReachedWarehouseRequest{
+			Location:     &apiv1.Location{},
+			Announcement: &apiv1.WarehouseAnnouncement{CargoUnitId: m.cargoUnitID, WarehouseId: m.warehouseID},
+		})
+	}
+
+	resp, err := ls.ExportWarehouseSuppliers(ctx, &apiv1.ExportWarehouseSuppliersRequest{})
+	if err != nil {
+		t.Fatalf("Not expected error from ExportWarehouseSuppliers, error: %v", err)
+	}
+
+	warehouses := resp.GetWarehouses()
+	if len(warehouses) != 2 {
+		t.Fatalf("Expected 2 warehouses, but got %d", len(warehouses))
+	}
+	if warehouses[0].GetWarehouseId() != 10 || warehouses[0].GetDeliveryCount() != 1 {
+		t.Errorf("Unexpected first warehouse %v", warehouses[0])
+	}
+	if warehouses[1].GetWarehouseId() != 20 || warehouses[1].GetDeliveryCount() != 2 {
+		t.Errorf("Unexpected second warehouse %v", warehouses[1])
+	}
+	if supplier := warehouses[1].GetSuppliers()[0]; supplier.GetCargoUnitId() != 1 || supplier.GetPathLength() != 3 {
+		t.Errorf("Unexpected supplier %v", supplier)
+	}
+}
+
+func TestAPILogisticsServerExportWarehouseSuppliersOfRun(t *testing.T) {
+	ls := NewLogisticsServer(store.NewMemoryStore(), NewMovementBroker(), NewUnitCounter(), NewIdempotencyCache())
+	ctx := context.Background()
+
+	for _, runID := range []string{"first", "second"} {
+		_, _ = ls.MoveUnit(ctx, &apiv1.MoveUnitRequest{CargoUnitId: 1, Location: &apiv1.Location{}, RunId: runID})
+		_, _ = ls.UnitReachedWarehouse(ctx, &apiv1.UnitReachedWarehouseRequest{
+			Location:     &apiv1.Location{},
+			Announcement: &apiv1.WarehouseAnnouncement{CargoUnitId: 1, WarehouseId: 10},
+			RunId:        runID,
+		})
+	}
+
+	all, _ := ls.ExportWarehouseSuppliers(ctx, &apiv1.ExportWarehouseSuppliersRequest{})
+	if len(all.GetWarehouses()) != 1 || all.GetWarehouses()[0].GetDeliveryCount() != 2 {
+		t.Fatalf("Expected cargo unit 1 of both runs to deliver to warehouse 10, but got %v", all.GetWarehouses())
+	}
+	for _, supplier := range all.GetWarehouses()[0].GetSuppliers() {
+		if supplier.GetPathLength() != 1 {
+			t.Errorf("Expected path of every run not to be merged with other run, but got %v", supplier)
+		}
+	}
+
+	second, _ := ls.ExportWarehouseSuppliers(ctx, &apiv1.ExportWarehouseSuppliersRequest{RunId: "second"})
+	if suppliers := second.GetWarehouses()[0].GetSuppliers(); len(suppliers) != 1 || suppliers[0].GetRunId() != "second" {
+		t.Errorf("Expected only supplier of second run, but got %v", suppliers)
+	}
+}
+
+func TestAPILogisticsServerAppliesRetryOnce(t *testing.T) {
+	ls := NewLogisticsServer(store.NewMemoryStore(), NewMovementBroker(), NewUnitCounter(), NewIdempotencyCache())
+	ctx := context.Background()
+
+	req := &apiv1.MoveUnitRequest{CargoUnitId: 1, Location: &apiv1.Location{Latitude: 1}, IdempotencyKey: "move-1"}
+	for i := 0; i < 3; i++ {
+		if _, err := ls.MoveUnit(ctx, req); err != nil {
+			t.Errorf("Not expected error from MoveUnit, error: %v", err)
+		}
+	}
+
+	path, _, _ := ls.deliveryPaths.Get(ctx, "", 1)
+	if len(path.Path) != 1 {
+		t.Errorf("Expected retried move to be stored once, but got path %v", path.Path)
+	}
+	counts, _ := ls.GetReceivedCounts(ctx, &apiv1.GetReceivedCountsRequest{})
+	if moves := counts.GetCargoUnits()[0].GetMoveUnitCount(); moves != 1 {
+		t.Errorf("Expected retried move to be counted once, but got %d", moves)
+	}
+	if total := ls.TotalReceived(); total != 3 {
+		t.Errorf("Expected every retry to be received, but got %d", total)
+	}
+}
+
+// flakyStore fails first fail appends and counts appends that reached store, first append waits for gate if it is set
+type flakyStore struct {
+	*store.MemoryStore
+
+	fail, appends int
+	entered, gate chan struct{}
+	sync.Mutex
+}
+
+func (s *flakyStore) AppendLocation(ctx context.Context, runID string, cargoUnitID int64, location model.Coordinate) error {
+	s.Lock()
+	s.appends++
+	first := s.appends == 1
+	failed := s.appends <= s.fail
+	s.Unlock()
+
+	if first && s.gate != nil {
+		close(s.entered)
+		<-s.gate
+	}
+	if failed {
+		return errors.New("disk full")
+	}
+
+	return s.MemoryStore.AppendLocation(ctx, runID, cargoUnitID, location)
+}
+
+func TestAPILogisticsServerAppliesConcurrentRetryOnce(t *testing.T) {
+	const retries = 32
+
+	paths := &flakyStore{MemoryStore: store.NewMemoryStore()}
+	ls := NewLogisticsServer(paths, NewMovementBroker(), NewUnitCounter(), NewIdempotencyCache())
+	ctx := context.Background()
+
+	req := &apiv1.MoveUnitRequest{CargoUnitId: 1, Location: &apiv1.Location{Latitude: 1}, IdempotencyKey: "move-1"}
+	var wg sync.WaitGroup
+	for i := 0; i < retries; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := ls.MoveUnit(ctx, req); err != nil {
+				t.Errorf("Not expected error from MoveUnit, error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	path, _, _ := ls.deliveryPaths.Get(ctx, "", 1)
+	if paths.appends != 1 || len(path.Path) != 1 {
+		t.Errorf("Expected concurrent retries to be stored once, but got %d writes and path %v", paths.appends, path.Path)
+	}
+}
+
+func TestAPILogisticsServerAppliesRetryOfFailedRequest(t *testing.T) {
+	paths := &flakyStore{MemoryStore: store.NewMemoryStore(), fail: 1}
+	ls := NewLogisticsServer(paths, NewMovementBroker(), NewUnitCounter(), NewIdempotencyCache())
+	ctx := context.Background()
+
+	req := &apiv1.MoveUnitRequest{CargoUnitId: 1, Location: &apiv1.Location{Latitude: 1}, IdempotencyKey: "move-1"}
+	if _, err := ls.MoveUnit(ctx, req); status.Code(err) != codes.Internal {
+		t.Fatalf("Expected Internal error when store fails, but got %v", err)
+	}
+	if _, err := ls.MoveUnit(ctx, req); err != nil {
+		t.Fatalf("Not expected error from retried MoveUnit, error: %v", err)
+	}
+
+	if path, _, _ := ls.deliveryPaths.Get(ctx, "", 1); len(path.Path) != 1 {
+		t.Errorf("Expected retry of failed move to be stored, but got path %v", path.Path)
+	}
+}
+
+func TestAPILogisticsServerAppliesConcurrentRetryOfFailingRequest(t *testing.T) {
+	paths := &flakyStore{MemoryStore: store.NewMemoryStore(), fail: 1, entered: make(chan struct{}), gate: make(chan struct{})}
+	ls := NewLogisticsServer(paths, NewMovementBroker(), NewUnitCounter(), NewIdempotencyCache())
+	ctx := context.Background()
+
+	req := &apiv1.MoveUnitRequest{CargoUnitId: 1, Location: &apiv1.Location{Latitude: 1}, IdempotencyKey: "move-1"}
+	first := make(chan error, 1)
+	go func() {
+		_, err := ls.MoveUnit(ctx, req)
+		first <- err
+	}()
+	<-paths.entered
+
+	retried := make(chan error, 1)
+	go func() {
+		_, err := ls.MoveUnit(ctx, req)
+		retried <- err
+	}()
+	select {
+	case err := <-retried:
+		t.Fatalf("Expected retry to wait for pending first attempt, but got response with error: %v", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(paths.gate)
+	if err := <-first; status.Code(err) != codes.Internal {
+		t.Errorf("Expected Internal error when store fails, but got %v", err)
+	}
+	if err := <-retried; err != nil {
+		t.Errorf("Not expected error from retried MoveUnit, error: %v", err)
+	}
+
+	if path, _, _ := ls.deliveryPaths.Get(ctx, "", 1); len(path.Path) != 1 {
+		t.Errorf("Expected retry of failed move to be stored, but got path %v", path.Path)
+	}
+}
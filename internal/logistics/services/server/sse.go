@@ -0,0 +1,106 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const sseKeepAliveInterval = 15 * time.Second
+
+// MovementEventsHandler serves WatchUnitMovements feed as Server-Sent Events.
+// Supports optional cargo_unit_id and warehouse_id query parameters like WatchUnitMovementsRequest.
+func (ls *APILogisticsServer) MovementEventsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		filter, filterErr := parseMovementFilter(r)
+		if filterErr != nil {
+			http.Error(w, filterErr.Error(), http.StatusBadRequest)
+			return
+		}
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+			return
+		}
+
+		subscription := ls.movements.Subscribe(filter)
+		defer ls.movements.Unsubscribe(subscription)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		keepAlive := time.NewTicker(sseKeepAliveInterval)
+		defer keepAlive.Stop()
+
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-keepAlive.C:
+				_, _ = fmt.Fprint(w, ": keep-alive\n\n")
+			case event, ok := <-subscription.Events():
+				if !ok {
+					_, _ = fmt.Fprintf(w, "event: end\ndata: %s\n\n", subscriptionEndError(subscription))
+					flusher.Flush()
+					return
+				}
+				if writeErr := writeMovementEvent(w, event); writeErr != nil {
+					return
+				}
+			}
+
+			flusher.Flush()
+		}
+	})
+}
+
+func writeMovementEvent(w http.ResponseWriter, event *apiv1.UnitMovementEvent) error {
+	name := "moved"
+	if event.GetReached() != nil {
+		name = "reached"
+	}
+
+	data, marshalErr := protojson.Marshal(event)
+	if marshalErr != nil {
+		return marshalErr
+	}
+
+	_, writeErr := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, data)
+
+	return writeErr
+}
+
+func parseMovementFilter(r *http.Request) (MovementFilter, error) {
+	var filter MovementFilter
+
+	for _, param := range []struct {
+		names  []string
+		target **int64
+	}{
+		{names: []string{"cargo_unit_id", "cargoUnitId"}, target: &filter.CargoUnitID},
+		{names: []string{"warehouse_id", "warehouseId"}, target: &filter.WarehouseID},
+	} {
+		for _, name := range param.names {
+			value := r.URL.Query().Get(name)
+			if len(value) == 0 {
+				continue
+			}
+
+			id, parseErr := strconv.ParseInt(value, 10, 64)
+			if parseErr != nil {
+				return filter, fmt.Errorf("invalid %s: %w", name, parseErr)
+			}
+			*param.target = &id
+		}
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,205 @@
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/coopnorge/interview-backend/internal/logistics/model"
+)
+
+type logOperation string
+
+const (
+	logOperationMove    logOperation = "move"
+	logOperationReached logOperation = "reached"
+)
+
+// logRecord is single line of append-only log
+type logRecord struct {
+	Operation   logOperation `json:"op"`
+	RunID       string       `json:"run_id,omitempty"`
+	CargoUnitID int64        `json:"cargo_unit_id"`
+	X           int          `json:"x,omitempty"`
+	Y           int          `json:"y,omitempty"`
+	WarehouseID int64        `json:"warehouse_id,omitempty"`
+}
+
+// FileStore keeps delivery paths in memory and appends every change to a log file,
+// the log is replayed on startup so paths survive restarts.
+type FileStore struct {
+	memory *MemoryStore
+	file   logFile
+	// size of log that contains only complete records
+	size int64
+
+	sync.Mutex
+}
+
+// logFile of FileStore, *os.File outside of tests
+type logFile interface {
+	io.WriteSeeker
+	Truncate(size int64) error
+	Sync() error
+	Close() error
+}
+
+// NewFileStore opens or creates log at path and recovers delivery paths from it.
+// Incomplete record at the end of log (torn write) is discarded.
+func NewFileStore(path string) (*FileStore, error) {
+	file, openErr := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if openErr != nil {
+		return nil, fmt.Errorf("failed to open delivery path log %s, error: %w", path, openErr)
+	}
+
+	memory := NewMemoryStore()
+	validSize, recoverErr := replayLog(file, memory)
+	if recoverErr != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("failed to recover delivery path log %s, error: %w", path, recoverErr)
+	}
+
+	if truncateErr := file.Truncate(validSize); truncateErr != nil {
+		_ = file.Close()
+		return nil, truncateErr
+	}
+	if _, seekErr := file.Seek(validSize, io.SeekStart); seekErr != nil {
+		_ = file.Close()
+		return nil, seekErr
+	}
+
+	return &FileStore{memory: memory, file: file, size: validSize}, nil
+}
+
+// AppendLocation to the end of cargo unit delivery path
+func (s *FileStore) AppendLocation(ctx context.Context, runID string, cargoUnitID int64, location model.Coordinate) error {
+	s.Lock()
+	defer s.Unlock()
+
+	record := logRecord{Operation: logOperationMove, RunID: runID, CargoUnitID: cargoUnitID, X: location.X, Y: location.Y}
+	if err := s.write(record); err != nil {
+		return err
+	}
+
+	return s.memory.AppendLocation(ctx, runID, cargoUnitID, location)
+}
+
+// MarkReached warehouse by cargo unit
+func (s *FileStore) MarkReached(ctx context.Context, runID string, cargoUnitID, warehouseID int64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	record := logRecord{Operation: logOperationReached, RunID: runID, CargoUnitID: cargoUnitID, WarehouseID: warehouseID}
+	if err := s.write(record); err != nil {
+		return err
+	}
+
+	return s.memory.MarkReached(ctx, runID, cargoUnitID, warehouseID)
+}
+
+// Get delivery path of cargo unit in run
+func (s *FileStore) Get(ctx context.Context, runID string, cargoUnitID int64) (model.DeliveryPath, bool, error) {
+	return s.memory.Get(ctx, runID, cargoUnitID)
+}
+
+// List all delivery paths ordered by run ID and cargo unit ID
+func (s *FileStore) List(ctx context.Context) ([]model.DeliveryPath, error) {
+	return s.memory.List(ctx)
+}
+
+// Close flushes log to disk and closes it
+func (s *FileStore) Close() error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+
+	syncErr := s.file.Sync()
+	closeErr := s.file.Close()
+	s.file = nil
+
+	return errors.Join(syncErr, closeErr)
+}
+
+func (s *FileStore) write(record logRecord) error {
+	if s.file == nil {
+		return os.ErrClosed
+	}
+
+	line, marshalErr := json.Marshal(record)
+	if marshalErr != nil {
+		return marshalErr
+	}
+
+	line = append(line, '\n')
+	written, writeErr := s.file.Write(line)
+	if writeErr == nil && written < len(line) {
+		writeErr = io.ErrShortWrite
+	}
+	if writeErr != nil {
+		return s.discardTornRecord(writeErr)
+	}
+	s.size += int64(written)
+
+	return nil
+}
+
+// discardTornRecord that failed with writeErr, so next record is appended after last complete one.
+// Log is closed if it can't be restored, as records appended after torn one would be lost on recovery.
+func (s *FileStore) discardTornRecord(writeErr error) error {
+	truncateErr := s.file.Truncate(s.size)
+	if truncateErr == nil {
+		_, truncateErr = s.file.Seek(s.size, io.SeekStart)
+	}
+	if truncateErr != nil {
+		_ = s.file.Close()
+		s.file = nil
+
+		return fmt.Errorf("failed to write delivery path log, error: %w", errors.Join(writeErr, truncateErr))
+	}
+
+	return fmt.Errorf("failed to write delivery path log, error: %w", writeErr)
+}
+
+// replayLog into memory store and return size of log that contains only complete records
+func replayLog(r io.Reader, memory *MemoryStore) (int64, error) {
+	ctx := context.Background()
+	reader := bufio.NewReader(r)
+
+	var validSize int64
+	for lineNumber := 1; ; lineNumber++ {
+		line, readErr := reader.ReadBytes('\n')
+		if errors.Is(readErr, io.EOF) {
+			// Anything left without new line is incomplete record
+			return validSize, nil
+		} else if readErr != nil {
+			return 0, readErr
+		}
+
+		if len(bytes.TrimSpace(line)) > 0 {
+			var record logRecord
+			if unmarshalErr := json.Unmarshal(line, &record); unmarshalErr != nil {
+				return 0, fmt.Errorf("corrupted record at line %d: %w", lineNumber, unmarshalErr)
+			}
+
+			switch record.Operation {
+			case logOperationMove:
+				_ = memory.AppendLocation(ctx, record.RunID, record.CargoUnitID, model.Coordinate{X: record.X, Y: record.Y})
+			case logOperationReached:
+				_ = memory.MarkReached(ctx, record.RunID, record.CargoUnitID, record.WarehouseID)
+			default:
+				return 0, fmt.Errorf("unknown operation %q at line %d", record.Operation, lineNumber)
+			}
+		}
+
+		validSize += int64(len(line))
+	}
+}
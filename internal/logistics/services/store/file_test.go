@@ -0,0 +1,117 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/coopnorge/interview-backend/internal/logistics/model"
+)
+
+func TestFileStoreRecovery(t *testing.T) {
+	ctx := context.Background()
+	logPath := filepath.Join(t.TempDir(), "paths.log")
+
+	s, err := NewFileStore(logPath)
+	if err != nil {
+		t.Fatalf("Not expected error when creating file store, error: %v", err)
+	}
+	_ = s.AppendLocation(ctx, "run", 1, model.Coordinate{X: 1, Y: 2})
+	_ = s.AppendLocation(ctx, "run", 1, model.Coordinate{X: 2, Y: 3})
+	_ = s.MarkReached(ctx, "run", 1, 7)
+	if closeErr := s.Close(); closeErr != nil {
+		t.Fatalf("Not expected error when closing file store, error: %v", closeErr)
+	}
+
+	// Simulate crash in the middle of writing record
+	f, _ := os.OpenFile(logPath, os.O_APPEND|os.O_WRONLY, 0o644)
+	_, _ = f.WriteString(`{"op":"move","cargo_unit_id":2,"x":`)
+	_ = f.Close()
+
+	recovered, err := NewFileStore(logPath)
+	if err != nil {
+		t.Fatalf("Not expected error when recovering file store, error: %v", err)
+	}
+	defer recovered.Close()
+
+	path, found, _ := recovered.Get(ctx, "run", 1)
+	if !found || len(path.Path) != 2 || !path.Reached || path.WarehouseID != 7 {
+		t.Errorf("Delivery path was not recovered, got %+v", path)
+	}
+	if _, found, _ = recovered.Get(ctx, "run", 2); found {
+		t.Errorf("Incomplete record must be discarded")
+	}
+
+	// New records must be appended after last complete one
+	_ = recovered.AppendLocation(ctx, "run", 2, model.Coordinate{X: 9, Y: 9})
+	_ = recovered.Close()
+
+	reopened, err := NewFileStore(logPath)
+	if err != nil {
+		t.Fatalf("Not expected error when reopening file store, error: %v", err)
+	}
+	defer reopened.Close()
+
+	if path, found, _ = reopened.Get(ctx, "run", 2); !found || len(path.Path) != 1 {
+		t.Errorf("Expected cargo unit 2 with single location, got %+v", path)
+	}
+}
+
+func TestFileStoreCorruptedLog(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "paths.log")
+	_ = os.WriteFile(logPath, []byte("not a record\n{\"op\":\"reached\",\"cargo_unit_id\":1}\n"), 0o644)
+
+	if _, err := NewFileStore(logPath); err == nil {
+		t.Errorf("Expected error when log contains corrupted record")
+	}
+}
+
+// tornFile writes only half of next record and fails, like disk that filled up in the middle of write
+type tornFile struct {
+	*os.File
+	torn bool
+}
+
+func (f *tornFile) Write(p []byte) (int, error) {
+	if f.torn {
+		return f.File.Write(p)
+	}
+	f.torn = true
+
+	written, _ := f.File.Write(p[:len(p)/2])
+
+	return written, errors.New("no space left on device")
+}
+
+func TestFileStoreDiscardsTornWrite(t *testing.T) {
+	ctx := context.Background()
+	logPath := filepath.Join(t.TempDir(), "paths.log")
+
+	s, err := NewFileStore(logPath)
+	if err != nil {
+		t.Fatalf("Not expected error when creating file store, error: %v", err)
+	}
+	_ = s.AppendLocation(ctx, "run", 1, model.Coordinate{X: 1, Y: 2})
+
+	s.file = &tornFile{File: s.file.(*os.File)}
+	if appendErr := s.AppendLocation(ctx, "run", 2, model.Coordinate{X: 3, Y: 4}); appendErr == nil {
+		t.Fatalf("Expected error from torn write")
+	}
+	if appendErr := s.AppendLocation(ctx, "run", 3, model.Coordinate{X: 5, Y: 6}); appendErr != nil {
+		t.Fatalf("Not expected error from write after torn one, error: %v", appendErr)
+	}
+	_ = s.Close()
+
+	reopened, err := NewFileStore(logPath)
+	if err != nil {
+		t.Fatalf("Expected torn record to be removed from log, but got error: %v", err)
+	}
+	defer reopened.Close()
+
+	paths, _ := reopened.List(ctx)
+	if len(paths) != 2 || paths[0].CargoUnitID != 1 || paths[1].CargoUnitID != 3 {
+		t.Errorf("Expected delivery paths of cargo units 1 and 3, but got %+v", paths)
+	}
+}
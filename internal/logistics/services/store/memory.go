@@ -0,0 +1,99 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/coopnorge/interview-backend/internal/logistics/model"
+)
+
+// MemoryStore keeps delivery paths in memory only
+type MemoryStore struct {
+	paths map[pathKey]*model.DeliveryPath
+
+	sync.RWMutex
+}
+
+// pathKey of cargo unit in client run
+type pathKey struct {
+	runID       string
+	cargoUnitID int64
+}
+
+// NewMemoryStore instance
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{paths: make(map[pathKey]*model.DeliveryPath)}
+}
+
+// AppendLocation to the end of cargo unit delivery path
+func (s *MemoryStore) AppendLocation(_ context.Context, runID string, cargoUnitID int64, location model.Coordinate) error {
+	s.Lock()
+	defer s.Unlock()
+
+	path := s.getOrCreate(runID, cargoUnitID)
+	path.Path = append(path.Path, location)
+
+	return nil
+}
+
+// MarkReached warehouse by cargo unit
+func (s *MemoryStore) MarkReached(_ context.Context, runID string, cargoUnitID, warehouseID int64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	path := s.getOrCreate(runID, cargoUnitID)
+	path.WarehouseID = warehouseID
+	path.Reached = true
+
+	return nil
+}
+
+// Get delivery path of cargo unit
+func (s *MemoryStore) Get(_ context.Context, runID string, cargoUnitID int64) (model.DeliveryPath, bool, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	path, ok := s.paths[pathKey{runID: runID, cargoUnitID: cargoUnitID}]
+	if !ok {
+		return model.DeliveryPath{}, false, nil
+	}
+
+	return path.Copy(), true, nil
+}
+
+// List all delivery paths ordered by run ID and cargo unit ID
+func (s *MemoryStore) List(_ context.Context) ([]model.DeliveryPath, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	paths := make([]model.DeliveryPath, 0, len(s.paths))
+	for _, path := range s.paths {
+		paths = append(paths, path.Copy())
+	}
+
+	sort.Slice(paths, func(i, j int) bool {
+		if paths[i].RunID != paths[j].RunID {
+			return paths[i].RunID < paths[j].RunID
+		}
+		return paths[i].CargoUnitID < paths[j].CargoUnitID
+	})
+
+	return paths, nil
+}
+
+// Close is no-op for in-memory store
+func (s *MemoryStore) Close() error {
+	return nil
+}
+
+func (s *MemoryStore) getOrCreate(runID string, cargoUnitID int64) *model.DeliveryPath {
+	key := pathKey{runID: runID, cargoUnitID: cargoUnitID}
+	path, ok := s.paths[key]
+	if !ok {
+		path = &model.DeliveryPath{RunID: runID, CargoUnitID: cargoUnitID}
+		s.paths[key] = path
+	}
+
+	return path
+}
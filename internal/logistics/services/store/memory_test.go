@@ -0,0 +1,63 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/coopnorge/interview-backend/internal/logistics/model"
+)
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+
+	_ = s.AppendLocation(ctx, "run", 2, model.Coordinate{X: 1, Y: 1})
+	_ = s.AppendLocation(ctx, "run", 1, model.Coordinate{X: 5, Y: 5})
+	_ = s.AppendLocation(ctx, "run", 1, model.Coordinate{X: 4, Y: 4})
+	_ = s.MarkReached(ctx, "run", 1, 10)
+
+	path, found, err := s.Get(ctx, "run", 1)
+	if err != nil || !found {
+		t.Fatalf("Expected to find cargo unit 1, found: %t, error: %v", found, err)
+	}
+	if len(path.Path) != 2 || path.Path[1] != (model.Coordinate{X: 4, Y: 4}) {
+		t.Errorf("Unexpected delivery path %v", path.Path)
+	}
+	if !path.Reached || path.WarehouseID != 10 {
+		t.Errorf("Expected cargo unit 1 to reach warehouse 10, but got %+v", path)
+	}
+
+	// Returned path must not share memory with store
+	path.Path[0] = model.Coordinate{}
+	storedPath, _, _ := s.Get(ctx, "run", 1)
+	if storedPath.Path[0] != (model.Coordinate{X: 5, Y: 5}) {
+		t.Errorf("Store was modified through returned delivery path")
+	}
+
+	paths, _ := s.List(ctx)
+	if len(paths) != 2 || paths[0].CargoUnitID != 1 || paths[1].CargoUnitID != 2 {
+		t.Errorf("Expected delivery paths ordered by cargo unit ID, but got %+v", paths)
+	}
+
+	if _, found, _ = s.Get(ctx, "run", 3); found {
+		t.Errorf("Not expected to find unknown cargo unit")
+	}
+}
+
+func TestMemoryStoreScopesRuns(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+
+	_ = s.AppendLocation(ctx, "second", 1, model.Coordinate{X: 1, Y: 1})
+	_ = s.AppendLocation(ctx, "first", 1, model.Coordinate{X: 2, Y: 2})
+	_ = s.MarkReached(ctx, "first", 1, 10)
+
+	if path, _, _ := s.Get(ctx, "second", 1); len(path.Path) != 1 || path.Reached {
+		t.Errorf("Expected path of cargo unit 1 in second run not to be merged with first run, but got %+v", path)
+	}
+
+	paths, _ := s.List(ctx)
+	if len(paths) != 2 || paths[0].RunID != "first" || paths[1].RunID != "second" {
+		t.Errorf("Expected delivery path of cargo unit 1 in every run ordered by run ID, but got %+v", paths)
+	}
+}
@@ -0,0 +1,42 @@
+package store
+
+import (
+	"context"
+
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+	"github.com/coopnorge/interview-backend/internal/logistics/model"
+	"github.com/google/wire"
+)
+
+// ServiceSetForStore providers
+var ServiceSetForStore = wire.NewSet(NewDeliveryPathStore)
+
+// DeliveryPathStore persists delivery path of each cargo unit per client run, since cargo unit IDs start over in
+// every run
+type DeliveryPathStore interface {
+	// AppendLocation to the end of cargo unit delivery path
+	AppendLocation(ctx context.Context, runID string, cargoUnitID int64, location model.Coordinate) error
+	// MarkReached warehouse by cargo unit
+	MarkReached(ctx context.Context, runID string, cargoUnitID, warehouseID int64) error
+	// Get delivery path of cargo unit in run, false if unit is unknown
+	Get(ctx context.Context, runID string, cargoUnitID int64) (model.DeliveryPath, bool, error)
+	// List all delivery paths ordered by run ID and cargo unit ID
+	List(ctx context.Context) ([]model.DeliveryPath, error)
+	// Close store and release resources
+	Close() error
+}
+
+// NewDeliveryPathStore selected by configuration, file-backed if StorePath is set otherwise in-memory
+func NewDeliveryPathStore(cfg *config.ServerAppConfig) (DeliveryPathStore, func(), error) {
+	if len(cfg.StorePath) == 0 {
+		s := NewMemoryStore()
+		return s, func() { _ = s.Close() }, nil
+	}
+
+	s, err := NewFileStore(cfg.StorePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s, func() { _ = s.Close() }, nil
+}
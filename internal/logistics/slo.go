@@ -0,0 +1,143 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/coopnorge/interview-backend/internal/logistics/model"
+	"github.com/coopnorge/interview-backend/internal/logistics/report"
+)
+
+// ErrSLOViolated returned by Run when any SLO assertion of the run failed
+var ErrSLOViolated = errors.New("SLO violated")
+
+// assertSLOs configured in config against statistics of finished run, empty if nothing is asserted
+func (s *ServiceInstance) assertSLOs() []report.Check {
+	slo := s.cfg.SLO
+	var checks []report.Check
+
+	if slo.MaxErrorRate >= 0 {
+		for _, o := range s.statistics.Operation {
+			rate := errorRate(o)
+			checks = append(checks, report.Check{
+				Name:      o.Name + " error rate",
+				Passed:    rate <= slo.MaxErrorRate,
+				Threshold: "<= " + formatPercent(slo.MaxErrorRate),
+				Actual:    formatPercent(rate),
+			})
+		}
+	}
+
+	if slo.MaxP99 > 0 {
+		for _, o := range s.statistics.Operation {
+			latency := &o.Latency
+			if s.statistics.Schedule != nil { // Open loop is judged by latency from scheduled send time
+				latency = &o.CorrectedLatency
+			}
+
+			check := report.Check{Name: o.Name + " p99 latency", Threshold: "<= " + formatLatency(slo.MaxP99)}
+			if latency.Count() == 0 {
+				check.Skipped = true
+				check.Message = "no requests sent"
+			} else {
+				p99 := latency.Percentile(99)
+				check.Passed = p99 <= slo.MaxP99
+				check.Actual = formatLatency(p99)
+			}
+			checks = append(checks, check)
+		}
+	}
+
+	if slo.MinThroughput > 0 {
+		var succeeded uint64
+		for _, o := range s.statistics.Operation {
+			succeeded += o.Succeeded()
+		}
+
+		var throughput float64
+		if s.loadDuration > 0 {
+			throughput = float64(succeeded) / s.loadDuration.Seconds()
+		}
+		checks = append(checks, report.Check{
+			Name:      "Throughput",
+			Passed:    throughput >= slo.MinThroughput,
+			Threshold: fmt.Sprintf(">= %g requests/s", slo.MinThroughput),
+			Actual:    fmt.Sprintf("%.1f requests/s", throughput),
+		})
+	}
+
+	if slo.DeliveredWithin > 0 {
+		checks = append(checks, s.assertDelivery(slo.DeliveredWithin))
+	}
+
+	for i := range checks {
+		if !checks[i].Passed && !checks[i].Skipped {
+			checks[i].Message = fmt.Sprintf("%s, expected %s", checks[i].Actual, checks[i].Threshold)
+		}
+	}
+
+	return checks
+}
+
+// assertDelivery of every cargo unit to warehouse within given time from start of load
+func (s *ServiceInstance) assertDelivery(within time.Duration) report.Check {
+	check := report.Check{Name: "Cargo units delivered", Threshold: "all within " + within.String()}
+	switch {
+	case len(s.replayRecords) > 0:
+		check.Skipped = true
+		check.Message = "replay does not simulate deliveries"
+		return check
+	case s.interrupted.Load():
+		check.Skipped = true
+		check.Message = "run was interrupted"
+		return check
+	}
+
+	var delivered int
+	var lastDelivery time.Duration
+	for _, unit := range s.statistics.Units {
+		deliveredAt := unit.DeliveredAt()
+		if deliveredAt.IsZero() {
+			continue
+		}
+
+		delivered++
+		lastDelivery = max(lastDelivery, deliveredAt.Sub(s.loadStart))
+	}
+
+	check.Passed = delivered == len(s.statistics.Units) && lastDelivery <= within
+	check.Actual = fmt.Sprintf("%d of %d, last after %s", delivered, len(s.statistics.Units), lastDelivery.Round(time.Millisecond))
+
+	return check
+}
+
+// sloViolations of failed checks joined as error wrapping ErrSLOViolated, nil if every SLO passed
+func sloViolations(checks []report.Check) error {
+	var violated []string
+	for _, check := range checks {
+		if !check.Passed && !check.Skipped {
+			violated = append(violated, check.Name)
+		}
+	}
+	if len(violated) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%s, %w: %s", appName, ErrSLOViolated, strings.Join(violated, ", "))
+}
+
+// errorRate of operation as fraction of sent requests, zero if nothing was sent
+func errorRate(o *model.Operation) float64 {
+	if o.A == 0 {
+		return 0
+	}
+
+	return float64(o.B) / float64(o.A)
+}
+
+// formatPercent of fraction
+func formatPercent(fraction float64) string {
+	return fmt.Sprintf("%.2f%%", fraction*100)
+}
@@ -1,21 +1,52 @@
 package generator
 
 import (
+	"fmt"
 	"math/rand"
 
 	"github.com/coopnorge/interview-backend/internal/logistics/model"
 )
 
-// NewCoordinates with unique placement
-func NewCoordinates(numCoordinates, xRange, yRange int) []model.Coordinate {
-	coordinates := make([]model.Coordinate, numCoordinates)
+// NewCoordinates with unique placement drawn from random, error if there are fewer than numCoordinates cells
+func NewCoordinates(random *rand.Rand, numCoordinates, xRange, yRange int) ([]model.Coordinate, error) {
+	if numCoordinates < 0 || xRange <= 0 || yRange <= 0 {
+		return nil, fmt.Errorf("invalid %d coordinates on %dx%d map", numCoordinates, xRange, yRange)
+	}
+	cells := uint64(xRange) * uint64(yRange)
+	if uint64(numCoordinates) > cells {
+		return nil, fmt.Errorf("%d coordinates do not fit on %dx%d map of %d cells", numCoordinates, xRange, yRange, cells)
+	}
+
+	coordinates := make([]model.Coordinate, 0, numCoordinates)
+	taken := make(map[model.Coordinate]struct{}, numCoordinates)
+	// redraw taken cells while at most half of map is taken, then pick from free cells so dense maps finish
+	for len(coordinates) < numCoordinates && uint64(len(coordinates))*2 < cells {
+		c := model.Coordinate{X: random.Intn(xRange), Y: random.Intn(yRange)}
+		if _, ok := taken[c]; ok {
+			continue
+		}
 
-	for i := 0; i < numCoordinates; i++ {
-		x := rand.Intn(xRange)
-		y := rand.Intn(yRange)
+		taken[c] = struct{}{}
+		coordinates = append(coordinates, c)
+	}
+	if len(coordinates) == numCoordinates {
+		return coordinates, nil
+	}
 
-		coordinates[i] = model.Coordinate{X: x, Y: y}
+	free := make([]model.Coordinate, 0, int(cells)-len(coordinates))
+	for x := 0; x < xRange; x++ {
+		for y := 0; y < yRange; y++ {
+			if _, ok := taken[model.Coordinate{X: x, Y: y}]; !ok {
+				free = append(free, model.Coordinate{X: x, Y: y})
+			}
+		}
+	}
+	for len(coordinates) < numCoordinates {
+		i := random.Intn(len(free))
+		coordinates = append(coordinates, free[i])
+		free[i] = free[len(free)-1]
+		free = free[:len(free)-1]
 	}
 
-	return coordinates
+	return coordinates, nil
 }
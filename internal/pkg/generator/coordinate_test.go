@@ -11,7 +11,10 @@ func TestNewCoordinates(t *testing.T) {
 	xRange := 100
 	yRange := 100
 
-	coordinates := NewCoordinates(numCoordinates, xRange, yRange)
+	coordinates, coordinatesErr := NewCoordinates(NewRand(1), numCoordinates, xRange, yRange)
+	if coordinatesErr != nil {
+		t.Fatalf("Not expected error from NewCoordinates, error: %v", coordinatesErr)
+	}
 
 	// Check if the number of coordinates is correct
 	if len(coordinates) != numCoordinates {
@@ -32,3 +35,29 @@ func TestNewCoordinates(t *testing.T) {
 		}
 	}
 }
+
+func TestNewCoordinatesFillsMap(t *testing.T) {
+	xRange, yRange := 7, 5
+
+	coordinates, coordinatesErr := NewCoordinates(NewRand(1), xRange*yRange, xRange, yRange)
+	if coordinatesErr != nil {
+		t.Fatalf("Not expected error from NewCoordinates, error: %v", coordinatesErr)
+	}
+
+	visited := make(map[model.Coordinate]bool)
+	for _, c := range coordinates {
+		if visited[c] {
+			t.Errorf("Duplicate coordinate found: (%d, %d)", c.X, c.Y)
+		}
+		visited[c] = true
+	}
+	if len(visited) != xRange*yRange {
+		t.Errorf("Expected every of %d cells to be used, but got %d", xRange*yRange, len(visited))
+	}
+}
+
+func TestNewCoordinatesNotEnoughCells(t *testing.T) {
+	if _, coordinatesErr := NewCoordinates(NewRand(1), 26, 5, 5); coordinatesErr == nil {
+		t.Errorf("Expected error, since 26 coordinates do not fit on 5x5 map")
+	}
+}
@@ -0,0 +1,42 @@
+package generator
+
+import (
+	"math/rand"
+	"sync"
+)
+
+// NewRand from seed, safe for concurrent use unlike rand.New
+func NewRand(seed int64) *rand.Rand {
+	return rand.New(&lockedSource{source: rand.NewSource(seed).(rand.Source64)})
+}
+
+// lockedSource serializes access to wrapped source
+type lockedSource struct {
+	source rand.Source64
+
+	sync.Mutex
+}
+
+// Int63 impl
+func (s *lockedSource) Int63() int64 {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.source.Int63()
+}
+
+// Uint64 impl
+func (s *lockedSource) Uint64() uint64 {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.source.Uint64()
+}
+
+// Seed impl
+func (s *lockedSource) Seed(seed int64) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.source.Seed(seed)
+}
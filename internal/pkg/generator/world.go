@@ -2,41 +2,39 @@ package generator
 
 import (
 	"fmt"
-	"sync"
+	"math/rand"
 
 	gofakeit "github.com/brianvoe/gofakeit/v6"
 	"github.com/coopnorge/interview-backend/internal/logistics/model"
 )
 
-// AddNewActors by type to the model.Graph with actorNumber and from what ID it must be added (idPrefix)
-func AddNewActors(t model.ActorType, g *model.Graph, actorNumber uint, idPrefix uint) {
-	locsAndRange := int(actorNumber)
-	locations := NewCoordinates(locsAndRange, 1<<8-1, 1<<8-1)
-
-	var wg sync.WaitGroup
-	wg.Add(int(actorNumber))
+// AddNewActors by type to the model.Graph with actorNumber and from what ID it must be added (idPrefix),
+// placed on map of width and height. Locations and names are drawn from random in ID order,
+// so the same seed gives the same actors. Error if actors do not fit on the map.
+func AddNewActors(random *rand.Rand, t model.ActorType, g *model.Graph, actorNumber uint, idPrefix uint, width, height int) error {
+	locations, locationsErr := NewCoordinates(random, int(actorNumber), width, height)
+	if locationsErr != nil {
+		return locationsErr
+	}
+	faker := gofakeit.NewCustom(random)
 
 	for i := uint(0); i < actorNumber; i++ {
-		go func(i uint) {
-			defer wg.Done()
-
-			actorNode := model.GraphNode{ID: idPrefix + i}
+		actorNode := model.GraphNode{ID: idPrefix + i}
 
-			switch t {
-			case model.Warehouses:
-				actorNode.Name = fmt.Sprintf("Warehouse: %s - %s", gofakeit.City(), gofakeit.Company())
-				actorNode.Type = model.Warehouses
-			case model.CargoUnits:
-				actorNode.Name = fmt.Sprintf("CargoUnit: %s - %s", gofakeit.CarMaker(), gofakeit.CarModel())
-				actorNode.Type = model.CargoUnits
-				actorNode.Metadata = false // TODO Later can be fixed, but used to indicate if unit reached objective
-			}
+		switch t {
+		case model.Warehouses:
+			actorNode.Name = fmt.Sprintf("Warehouse: %s - %s", faker.City(), faker.Company())
+			actorNode.Type = model.Warehouses
+		case model.CargoUnits:
+			actorNode.Name = fmt.Sprintf("CargoUnit: %s - %s", faker.CarMaker(), faker.CarModel())
+			actorNode.Type = model.CargoUnits
+			actorNode.Metadata = false // TODO Later can be fixed, but used to indicate if unit reached objective
+		}
 
-			actorNode.Coordinate = &locations[i]
+		actorNode.Coordinate = &locations[i]
 
-			g.AddNode(actorNode)
-		}(i)
+		g.AddNode(actorNode)
 	}
 
-	wg.Wait()
+	return nil
 }
@@ -0,0 +1,135 @@
+package histogram
+
+import (
+	"math"
+	"math/bits"
+	"sync"
+	"time"
+)
+
+const (
+	// subBucketBits of precision, values are recorded with relative error below 1/(1<<(subBucketBits-1))
+	subBucketBits  = 8
+	subBucketCount = 1 << subBucketBits
+	subBucketHalf  = subBucketCount / 2
+	// bucketCount covers every uint64 value
+	bucketCount = subBucketCount + (64-subBucketBits)*subBucketHalf
+)
+
+// Histogram of durations in log-linear buckets like HdrHistogram, values below 256ns are exact
+// and larger ones are within 1%, zero value is ready to use and safe for concurrent use
+type Histogram struct {
+	counts []uint64
+	count  uint64
+	sum    time.Duration
+	min    time.Duration
+	max    time.Duration
+
+	sync.Mutex
+}
+
+// Record duration, negative durations are recorded as zero
+func (h *Histogram) Record(d time.Duration) {
+	d = max(d, 0)
+
+	h.Lock()
+	defer h.Unlock()
+
+	if h.counts == nil {
+		h.counts = make([]uint64, bucketCount)
+	}
+	h.counts[bucketIndex(uint64(d))]++
+
+	if h.count == 0 || d < h.min {
+		h.min = d
+	}
+	if d > h.max {
+		h.max = d
+	}
+	h.count++
+	h.sum += d
+}
+
+// Count of recorded durations
+func (h *Histogram) Count() uint64 {
+	h.Lock()
+	defer h.Unlock()
+
+	return h.count
+}
+
+// Min recorded duration, zero if empty
+func (h *Histogram) Min() time.Duration {
+	h.Lock()
+	defer h.Unlock()
+
+	return h.min
+}
+
+// Max recorded duration, zero if empty
+func (h *Histogram) Max() time.Duration {
+	h.Lock()
+	defer h.Unlock()
+
+	return h.max
+}
+
+// Mean of recorded durations, zero if empty
+func (h *Histogram) Mean() time.Duration {
+	h.Lock()
+	defer h.Unlock()
+
+	if h.count == 0 {
+		return 0
+	}
+
+	return h.sum / time.Duration(h.count)
+}
+
+// Percentile like 99.9 of recorded durations, highest duration of bucket limited by Max, zero if empty
+func (h *Histogram) Percentile(percentile float64) time.Duration {
+	h.Lock()
+	defer h.Unlock()
+
+	if h.count == 0 {
+		return 0
+	}
+
+	percentile = math.Min(math.Max(percentile, 0), 100)
+	rank := uint64(math.Ceil(percentile / 100 * float64(h.count)))
+	rank = max(rank, 1)
+
+	var seen uint64
+	for i, c := range h.counts {
+		seen += c
+		if seen >= rank {
+			return min(max(time.Duration(bucketHighest(i)), h.min), h.max)
+		}
+	}
+
+	return h.max
+}
+
+// bucketIndex of value, values below subBucketCount have own bucket,
+// larger ones share bucket with values of the same subBucketBits highest bits
+func bucketIndex(value uint64) int {
+	if value < subBucketCount {
+		return int(value)
+	}
+
+	shift := bits.Len64(value) - subBucketBits
+
+	return subBucketCount + (shift-1)*subBucketHalf + int(value>>shift) - subBucketHalf
+}
+
+// bucketHighest value that falls into bucket at index
+func bucketHighest(index int) uint64 {
+	if index < subBucketCount {
+		return uint64(index)
+	}
+
+	shift := (index-subBucketCount)/subBucketHalf + 1
+	lowest := uint64((index-subBucketCount)%subBucketHalf+subBucketHalf) << shift
+
+	return lowest + 1<<shift - 1
+}
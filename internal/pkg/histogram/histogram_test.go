@@ -0,0 +1,68 @@
+package histogram
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBucketIndexCoversValue(t *testing.T) {
+	for _, value := range []uint64{0, 1, 255, 256, 511, 512, 1000, 123456789, math.MaxInt64, math.MaxUint64} {
+		index := bucketIndex(value)
+		if index < 0 || index >= bucketCount {
+			t.Fatalf("Expected index of %d within %d buckets, but got %d", value, bucketCount, index)
+		}
+
+		highest := bucketHighest(index)
+		if highest < value {
+			t.Errorf("Expected bucket %d to hold %d, but highest value is %d", index, value, highest)
+		}
+		if value > 0 && float64(highest-value)/float64(value) > 0.01 {
+			t.Errorf("Expected bucket of %d within 1%%, but highest value is %d", value, highest)
+		}
+	}
+}
+
+func TestHistogramPercentiles(t *testing.T) {
+	var h Histogram
+	for i := 1; i <= 1000; i++ {
+		h.Record(time.Duration(i) * time.Microsecond)
+	}
+
+	if h.Count() != 1000 {
+		t.Errorf("Expected 1000 values, but got %d", h.Count())
+	}
+	if h.Min() != time.Microsecond || h.Max() != time.Millisecond {
+		t.Errorf("Expected min 1µs and max 1ms, but got %s and %s", h.Min(), h.Max())
+	}
+	if h.Mean() != 500500*time.Nanosecond {
+		t.Errorf("Expected mean 500.5µs, but got %s", h.Mean())
+	}
+
+	for percentile, expected := range map[float64]time.Duration{
+		50:   500 * time.Microsecond,
+		90:   900 * time.Microsecond,
+		99:   990 * time.Microsecond,
+		99.9: 999 * time.Microsecond,
+		100:  time.Millisecond,
+	} {
+		value := h.Percentile(percentile)
+		if value < expected || float64(value-expected) > float64(expected)*0.01 {
+			t.Errorf("Expected p%g within 1%% above %s, but got %s", percentile, expected, value)
+		}
+	}
+}
+
+func TestHistogramEmpty(t *testing.T) {
+	var h Histogram
+	h.Record(-time.Second)
+
+	if h.Percentile(99) != 0 || h.Min() != 0 || h.Max() != 0 {
+		t.Errorf("Expected negative duration to be recorded as zero")
+	}
+
+	var empty Histogram
+	if empty.Percentile(50) != 0 || empty.Mean() != 0 {
+		t.Errorf("Expected zero percentile and mean of empty histogram")
+	}
+}
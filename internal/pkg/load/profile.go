@@ -0,0 +1,234 @@
+package load
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// StageKind of load profile stage
+type StageKind string
+
+const (
+	// StageRamp changes value linearly from From to To.
+	StageRamp StageKind = "ramp"
+	// StageStep changes value from From to To in Steps equal stages.
+	StageStep StageKind = "step"
+	// StageHold keeps value at From.
+	StageHold StageKind = "hold"
+	// StageSpike keeps value at From, meant as short burst between other stages.
+	StageSpike StageKind = "spike"
+	// StageSoak keeps value at From, meant as long run that exposes leaks.
+	StageSoak StageKind = "soak"
+)
+
+// Stage of load profile that lasts Duration
+type Stage struct {
+	Kind     StageKind
+	From, To float64
+	// Steps of StageStep, at least 2.
+	Steps    int
+	Duration time.Duration
+}
+
+// Profile of consecutive stages that controls load over time
+type Profile struct {
+	Stages []Stage
+}
+
+// ParseProfile from comma separated stages in format kind:from[-to][xsteps]/duration,
+// like ramp:0-500/30s,step:100-500x5/50s,hold:500/1m,spike:2000/5s,soak:200/2h
+func ParseProfile(spec string) (*Profile, error) {
+	profile := &Profile{}
+	for _, stageSpec := range strings.Split(spec, ",") {
+		if len(strings.TrimSpace(stageSpec)) == 0 {
+			continue
+		}
+
+		stage, stageErr := parseStage(strings.TrimSpace(stageSpec))
+		if stageErr != nil {
+			return nil, fmt.Errorf("invalid load profile stage %q, error: %w", stageSpec, stageErr)
+		}
+		profile.Stages = append(profile.Stages, stage)
+	}
+
+	if len(profile.Stages) == 0 {
+		return nil, errors.New("load profile has no stages")
+	}
+
+	return profile, nil
+}
+
+func parseStage(spec string) (Stage, error) {
+	kind, rest, ok := strings.Cut(spec, ":")
+	if !ok {
+		return Stage{}, errors.New("expected kind:values/duration")
+	}
+	values, duration, ok := strings.Cut(rest, "/")
+	if !ok {
+		return Stage{}, errors.New("missing /duration")
+	}
+
+	stage := Stage{Kind: StageKind(kind)}
+
+	var durationErr error
+	if stage.Duration, durationErr = time.ParseDuration(duration); durationErr != nil {
+		return Stage{}, durationErr
+	}
+	if stage.Duration <= 0 {
+		return Stage{}, errors.New("duration must be positive")
+	}
+
+	if stage.Kind == StageStep {
+		var steps string
+		if values, steps, ok = strings.Cut(values, "x"); !ok {
+			return Stage{}, errors.New("missing xsteps")
+		}
+
+		var stepsErr error
+		if stage.Steps, stepsErr = strconv.Atoi(steps); stepsErr != nil || stage.Steps < 2 {
+			return Stage{}, errors.New("steps must be a number of at least 2")
+		}
+		if stage.Duration/time.Duration(stage.Steps) == 0 {
+			return Stage{}, fmt.Errorf("duration %s is too short for %d steps", stage.Duration, stage.Steps)
+		}
+	}
+
+	from, to, isRange := strings.Cut(values, "-")
+	var fromErr, toErr error
+	stage.From, fromErr = strconv.ParseFloat(from, 64)
+	stage.To = stage.From
+	if isRange {
+		stage.To, toErr = strconv.ParseFloat(to, 64)
+	}
+	if fromErr != nil || toErr != nil || stage.From < 0 || stage.To < 0 {
+		return Stage{}, errors.New("values must be non-negative numbers")
+	}
+
+	switch stage.Kind {
+	case StageRamp, StageStep:
+		if !isRange {
+			return Stage{}, fmt.Errorf("%s expects from-to values", stage.Kind)
+		}
+	case StageHold, StageSpike, StageSoak:
+		if isRange {
+			return Stage{}, fmt.Errorf("%s expects single value", stage.Kind)
+		}
+	default:
+		return Stage{}, fmt.Errorf("unknown kind %q", stage.Kind)
+	}
+
+	return stage, nil
+}
+
+// ValueAt elapsed time since start of stage
+func (s *Stage) ValueAt(elapsed time.Duration) float64 {
+	progress := math.Min(math.Max(float64(elapsed)/float64(s.Duration), 0), 1)
+
+	switch s.Kind {
+	case StageRamp:
+		return s.From + (s.To-s.From)*progress
+	case StageStep:
+		step := math.Min(math.Floor(progress*float64(s.Steps)), float64(s.Steps-1))
+		return s.From + (s.To-s.From)*step/float64(s.Steps-1)
+	default:
+		return s.From
+	}
+}
+
+// integral of value from start of stage until elapsed
+func (s *Stage) integral(elapsed time.Duration) float64 {
+	elapsed = min(max(elapsed, 0), s.Duration)
+	seconds := elapsed.Seconds()
+
+	switch s.Kind {
+	case StageRamp:
+		return s.From*seconds + (s.To-s.From)*seconds*seconds/(2*s.Duration.Seconds())
+	case StageStep:
+		stepDuration := s.Duration / time.Duration(s.Steps)
+		var sum float64
+		for start := time.Duration(0); start < elapsed; start += stepDuration {
+			sum += s.ValueAt(start) * (min(start+stepDuration, elapsed) - start).Seconds()
+		}
+		return sum
+	default:
+		return s.From * seconds
+	}
+}
+
+// String in format accepted by ParseProfile
+func (s *Stage) String() string {
+	value := strconv.FormatFloat(s.From, 'f', -1, 64)
+	switch s.Kind {
+	case StageRamp:
+		value += "-" + strconv.FormatFloat(s.To, 'f', -1, 64)
+	case StageStep:
+		value += "-" + strconv.FormatFloat(s.To, 'f', -1, 64) + "x" + strconv.Itoa(s.Steps)
+	}
+
+	return fmt.Sprintf("%s:%s/%s", s.Kind, value, s.Duration)
+}
+
+// Duration of all stages
+func (p *Profile) Duration() time.Duration {
+	var total time.Duration
+	for i := range p.Stages {
+		total += p.Stages[i].Duration
+	}
+
+	return total
+}
+
+// At elapsed time since start of profile returns value and index of active stage, index is -1 when profile ended
+func (p *Profile) At(elapsed time.Duration) (float64, int) {
+	for i := range p.Stages {
+		if elapsed < p.Stages[i].Duration {
+			return p.Stages[i].ValueAt(elapsed), i
+		}
+		elapsed -= p.Stages[i].Duration
+	}
+
+	return 0, -1
+}
+
+// Max value of any stage
+func (p *Profile) Max() float64 {
+	var maxValue float64
+	for i := range p.Stages {
+		maxValue = math.Max(maxValue, math.Max(p.Stages[i].From, p.Stages[i].To))
+	}
+
+	return maxValue
+}
+
+// Mean value from start of profile until elapsed, like target request rate of the time run took
+func (p *Profile) Mean(elapsed time.Duration) float64 {
+	if elapsed <= 0 {
+		return 0
+	}
+
+	var sum float64
+	remaining := elapsed
+	for i := range p.Stages {
+		sum += p.Stages[i].integral(remaining)
+		remaining -= p.Stages[i].Duration
+		if remaining <= 0 {
+			break
+		}
+	}
+
+	return sum / min(elapsed, p.Duration()).Seconds()
+}
+
+// String in format accepted by ParseProfile
+func (p *Profile) String() string {
+	stages := make([]string, len(p.Stages))
+	for i := range p.Stages {
+		stages[i] = p.Stages[i].String()
+	}
+
+	return strings.Join(stages, ",")
+}
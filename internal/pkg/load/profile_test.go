@@ -0,0 +1,86 @@
+package load
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestParseProfile(t *testing.T) {
+	spec := "ramp:0-100/10s,step:100-400x4/40s,hold:50/5s,spike:1000/1s,soak:200/1h"
+
+	profile, parseErr := ParseProfile(spec)
+	if parseErr != nil {
+		t.Fatalf("Not expected error from ParseProfile, error: %v", parseErr)
+	}
+
+	if len(profile.Stages) != 5 {
+		t.Fatalf("Expected 5 stages, but got %d", len(profile.Stages))
+	}
+	if profile.String() != "ramp:0-100/10s,step:100-400x4/40s,hold:50/5s,spike:1000/1s,soak:200/1h0m0s" {
+		t.Errorf("Expected profile to format back to spec, but got %s", profile)
+	}
+	if profile.Duration() != time.Hour+56*time.Second {
+		t.Errorf("Expected duration 1h0m56s, but got %s", profile.Duration())
+	}
+	if profile.Max() != 1000 {
+		t.Errorf("Expected max 1000, but got %f", profile.Max())
+	}
+}
+
+func TestParseProfileInvalid(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"ramp:100/10s",
+		"hold:1-2/10s",
+		"step:1-10/10s",
+		"step:1-10x1/10s",
+		"step:1-5x5/4ns",
+		"hold:-5/10s",
+		"hold:5/0s",
+		"hold:5",
+		"wave:5/10s",
+	} {
+		if _, err := ParseProfile(spec); err == nil {
+			t.Errorf("Expected error for spec %q", spec)
+		}
+	}
+}
+
+func TestProfileAt(t *testing.T) {
+	profile, _ := ParseProfile("ramp:0-100/10s,step:100-400x4/40s,spike:1000/1s")
+
+	cases := []struct {
+		elapsed time.Duration
+		value   float64
+		stage   int
+	}{
+		{0, 0, 0},
+		{5 * time.Second, 50, 0},
+		{10 * time.Second, 100, 1},
+		{25 * time.Second, 200, 1},
+		{49 * time.Second, 400, 1},
+		{50 * time.Second, 1000, 2},
+		{51 * time.Second, 0, -1},
+	}
+
+	for _, c := range cases {
+		value, stage := profile.At(c.elapsed)
+		if value != c.value || stage != c.stage {
+			t.Errorf("Expected value %f in stage %d at %s, but got %f in stage %d", c.value, c.stage, c.elapsed, value, stage)
+		}
+	}
+}
+
+func TestProfileMean(t *testing.T) {
+	profile, _ := ParseProfile("ramp:0-100/10s,step:100-400x4/40s")
+
+	if mean := profile.Mean(10 * time.Second); math.Abs(mean-50) > 1e-9 {
+		t.Errorf("Expected mean 50 of ramp, but got %f", mean)
+	}
+
+	// 500 from ramp and 10s of each step
+	if mean := profile.Mean(50 * time.Second); math.Abs(mean-(500+10000)/50.0) > 1e-9 {
+		t.Errorf("Expected mean 210 of whole profile, but got %f", mean)
+	}
+}
@@ -0,0 +1,164 @@
+package simulator
+
+import (
+	"time"
+
+	"github.com/coopnorge/interview-backend/internal/logistics/report"
+)
+
+// Result of run, the same as JSON report of logistics client
+type Result struct {
+	Name       string    `json:"name"`
+	StartedAt  time.Time `json:"started_at"`
+	DurationMs float64   `json:"duration_ms"`
+	Seed       int64     `json:"seed"`
+	// Interrupted run was stopped before it finished, statistics are partial
+	Interrupted bool        `json:"interrupted"`
+	Config      RunConfig   `json:"config"`
+	World       World       `json:"world"`
+	Retries     uint64      `json:"retries"`
+	Operations  []Operation `json:"operations"`
+	Schedule    *Schedule   `json:"schedule,omitempty"`
+	Stages      []Stage     `json:"stages,omitempty"`
+	Checks      []Check     `json:"checks"`
+}
+
+// RunConfig of client that produced result, without credentials
+type RunConfig struct {
+	Transport        string  `json:"transport"`
+	Address          string  `json:"address"`
+	TLS              bool    `json:"tls"`
+	LoadRate         float64 `json:"load_rate,omitempty"`
+	LoadProfile      string  `json:"load_profile,omitempty"`
+	LoadTarget       string  `json:"load_target,omitempty"`
+	ReplayPath       string  `json:"replay_path,omitempty"`
+	ReplaySpeed      float64 `json:"replay_speed,omitempty"`
+	RetryMaxAttempts int     `json:"retry_max_attempts"`
+}
+
+// World that was simulated
+type World struct {
+	Warehouses uint32 `json:"warehouses"`
+	CargoUnits uint32 `json:"cargo_units"`
+	Width      uint32 `json:"width"`
+	Height     uint32 `json:"height"`
+}
+
+// Operation statistics like MoveUnit
+type Operation struct {
+	Name    string  `json:"name"`
+	Count   uint64  `json:"count"`
+	Errors  uint64  `json:"errors"`
+	Latency Latency `json:"latency"`
+	// CorrectedLatency from scheduled send time, only in open loop
+	CorrectedLatency *Latency `json:"corrected_latency,omitempty"`
+}
+
+// Latency summary in milliseconds
+type Latency struct {
+	Count  uint64  `json:"count"`
+	MinMs  float64 `json:"min_ms"`
+	MeanMs float64 `json:"mean_ms"`
+	// PercentilesMs by name like p99.9
+	PercentilesMs map[string]float64 `json:"percentiles_ms"`
+	MaxMs         float64            `json:"max_ms"`
+}
+
+// Schedule of open loop
+type Schedule struct {
+	TargetRate   float64 `json:"target_rate"`
+	AchievedRate float64 `json:"achieved_rate"`
+	Sent         uint64  `json:"sent"`
+	Missed       uint64  `json:"missed_deadlines"`
+	MaxLateness  float64 `json:"max_lateness_ms"`
+}
+
+// Stage of load profile
+type Stage struct {
+	Name     string `json:"name"`
+	Requests uint64 `json:"requests"`
+	Errors   uint64 `json:"errors"`
+}
+
+// Check of run outcome like reconciliation with server
+type Check struct {
+	Name    string `json:"name"`
+	Passed  bool   `json:"passed"`
+	Skipped bool   `json:"skipped,omitempty"`
+	Message string `json:"message,omitempty"`
+	// Threshold and Actual value of SLO
+	Threshold string `json:"threshold,omitempty"`
+	Actual    string `json:"actual,omitempty"`
+}
+
+// Failed checks of result
+func (r *Result) Failed() []Check {
+	var failed []Check
+	for _, c := range r.Checks {
+		if !c.Passed && !c.Skipped {
+			failed = append(failed, c)
+		}
+	}
+
+	return failed
+}
+
+// newResult from report of logistics client, nil if there is no report
+func newResult(r *report.Report) *Result {
+	if r == nil {
+		return nil
+	}
+
+	result := &Result{
+		Name:        r.Name,
+		StartedAt:   r.StartedAt,
+		DurationMs:  r.DurationMs,
+		Seed:        r.Seed,
+		Interrupted: r.Interrupted,
+		Config:      RunConfig(r.Config),
+		World:       World(r.World),
+		Retries:     r.Retries,
+		Operations:  make([]Operation, len(r.Operations)),
+		Stages:      make([]Stage, len(r.Stages)),
+		Checks:      make([]Check, len(r.Checks)),
+	}
+	for i, operation := range r.Operations {
+		result.Operations[i] = Operation{
+			Name:    operation.Name,
+			Count:   operation.Count,
+			Errors:  operation.Errors,
+			Latency: newLatency(operation.Latency),
+		}
+		if operation.CorrectedLatency != nil {
+			corrected := newLatency(*operation.CorrectedLatency)
+			result.Operations[i].CorrectedLatency = &corrected
+		}
+	}
+	if r.Schedule != nil {
+		schedule := Schedule(*r.Schedule)
+		result.Schedule = &schedule
+	}
+	for i, stage := range r.Stages {
+		result.Stages[i] = Stage(stage)
+	}
+	for i, check := range r.Checks {
+		result.Checks[i] = Check(check)
+	}
+
+	return result
+}
+
+func newLatency(latency report.Latency) Latency {
+	percentiles := make(map[string]float64, len(latency.PercentilesMs))
+	for name, value := range latency.PercentilesMs {
+		percentiles[name] = value
+	}
+
+	return Latency{
+		Count:         latency.Count,
+		MinMs:         latency.MinMs,
+		MeanMs:        latency.MeanMs,
+		PercentilesMs: percentiles,
+		MaxMs:         latency.MaxMs,
+	}
+}
@@ -0,0 +1,303 @@
+// Package simulator runs Coop Logistics Engine client against API server from Go code,
+// so servers can use it as a test harness in their own integration tests.
+package simulator
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync/atomic"
+	"time"
+
+	internal "github.com/coopnorge/interview-backend/internal/logistics"
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+	"github.com/coopnorge/interview-backend/internal/logistics/report"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/client"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/operator"
+)
+
+// Operations reported in Event and Result
+const (
+	OperationMoveUnit             = "MoveUnit"
+	OperationUnitReachedWarehouse = "UnitReachedWarehouse"
+)
+
+// Targets of load profile
+const (
+	LoadTargetRate        = config.LoadTargetRate
+	LoadTargetConcurrency = config.LoadTargetConcurrency
+)
+
+var (
+	// ErrInterrupted returned with partial result of run stopped before it finished
+	ErrInterrupted = internal.ErrInterrupted
+	// ErrSLOViolated returned with result of run that failed any SLO
+	ErrSLOViolated = internal.ErrSLOViolated
+	// ErrProfileCutShort returned with result of run that delivered every cargo unit before load profile ended
+	ErrProfileCutShort = internal.ErrProfileCutShort
+	// ErrStarted returned when simulator is started second time, every simulator runs once
+	ErrStarted = errors.New("simulator already started")
+	// ErrNotStarted returned by Wait of simulator that was not started
+	ErrNotStarted = errors.New("simulator not started")
+)
+
+// Options of simulator, zero values fall back to the same defaults as environment of logistics client
+type Options struct {
+	// Address of API server like 127.0.0.1:50051
+	Address string
+	// Transport like gRPC, gRPCStream, HTTP, HTTPJSON or HTTPProtobuf, gRPC if empty
+	Transport string
+	// Scheme http or https of HTTP based transports
+	Scheme string
+	TLS    TLSOptions
+	Auth   AuthOptions
+	// Dialer of connections to server instead of network, like bufconn listener of in-process server
+	Dialer func(ctx context.Context, addr string) (net.Conn, error)
+	// Seed of generated world and its movements, random if nil, zero is valid seed like any other, see Seed
+	Seed *int64
+
+	World WorldOptions
+	Load  LoadOptions
+
+	// RetryMaxAttempts per request including first one, 1 disables retries
+	RetryMaxAttempts int
+	// ShutdownTimeout of in-flight requests after Stop
+	ShutdownTimeout time.Duration
+
+	Hooks Hooks
+	// Output of progress logs and printed report, discarded if nil
+	Output io.Writer
+}
+
+// TLSOptions of connection to server, plaintext if empty
+type TLSOptions struct {
+	// Config used as is instead of options below, like client config of httptest.Server with TLS
+	Config *tls.Config
+	// Enabled TLS with system root CAs, implied by any of files below
+	Enabled bool
+	// CAFile PEM bundle used instead of system root CAs to verify server
+	CAFile string
+	// CertFile and KeyFile PEM pair presented to server that requires mutual TLS
+	CertFile, KeyFile string
+	// ServerName expected in server certificate if it differs from host of Address
+	ServerName string
+}
+
+// AuthOptions of credentials attached to every request, anonymous if empty
+type AuthOptions struct {
+	// Token sent as bearer token in Authorization header
+	Token string
+	// TokenFile with bearer token, used instead of Token and re-read when it changes
+	TokenFile string
+	// APIKey sent in APIKeyHeader, X-API-Key if empty
+	APIKey       string
+	APIKeyHeader string
+}
+
+// WorldOptions of generated world
+type WorldOptions struct {
+	// Warehouses and CargoUnits in the world, random from 10-255 and 10-1024 if zero,
+	// each count must fit on the map and be at most config.MaxWorldActors
+	Warehouses, CargoUnits uint32
+	// Width and Height of map, at most config.MaxWorldSide
+	Width, Height uint32
+}
+
+// LoadOptions of requests sent to server, closed loop if empty
+type LoadOptions struct {
+	// Rate of requests per second sent in open loop regardless of response time
+	Rate float64
+	// Profile of load stages like ramp:0-500/30s,hold:500/1m, run ends with the profile
+	Profile string
+	// ProfileTarget is LoadTargetRate or LoadTargetConcurrency, rate if empty
+	ProfileTarget string
+	// MaxInFlight requests at any time, not limited beyond one per cargo unit in closed loop if 0
+	MaxInFlight int
+}
+
+// Hooks observing run, they are called concurrently from goroutines that sent requests
+type Hooks struct {
+	// OnRequest after every sent request
+	OnRequest func(Event)
+	// OnDelivered after server accepted that cargo unit reached warehouse
+	OnDelivered func(Event)
+}
+
+// Event of request sent to API server
+type Event struct {
+	// Operation like OperationMoveUnit or OperationUnitReachedWarehouse
+	Operation   string
+	CargoUnitID int64
+	// WarehouseID that cargo unit reached, zero for MoveUnit
+	WarehouseID         int64
+	Latitude, Longitude uint32
+	Sequence            uint64
+	SentAt              time.Time
+	// Latency of request including retries
+	Latency time.Duration
+	// Err of failed request, nil on success
+	Err error
+}
+
+// Simulator of cargo units that report their movements to API server
+type Simulator struct {
+	instance *internal.ServiceInstance
+
+	started atomic.Bool
+	done    chan struct{}
+	result  *Result
+	err     error
+}
+
+// New simulator with options, it connects to server and populates world only when started
+func New(opts Options) (*Simulator, error) {
+	cfg, cfgErr := newConfig(opts)
+	if cfgErr != nil {
+		return nil, cfgErr
+	}
+
+	logisticsClient, clientErr := client.NewLogisticsClientWithTransportOptions(cfg, client.TransportOptions{
+		TLSConfig: opts.TLS.Config,
+		Dialer:    opts.Dialer,
+	})
+	if clientErr != nil {
+		return nil, clientErr
+	}
+	random := operator.NewRandom(cfg)
+	instance, instanceErr := internal.NewServiceInstance(logisticsClient, operator.NewWorldOperator(random), random, cfg)
+	if instanceErr != nil {
+		return nil, instanceErr
+	}
+
+	output := opts.Output
+	if output == nil {
+		output = io.Discard
+	}
+	instance.SetOutput(output)
+	if opts.Hooks.OnRequest != nil || opts.Hooks.OnDelivered != nil {
+		instance.OnRequest(func(event internal.RequestEvent) { opts.Hooks.observe(Event(event)) })
+	}
+
+	return &Simulator{instance: instance, done: make(chan struct{})}, nil
+}
+
+// Start run in background, done ctx stops it like Stop
+func (sim *Simulator) Start(ctx context.Context) error {
+	if sim.started.Swap(true) {
+		return ErrStarted
+	}
+
+	go func() {
+		defer close(sim.done)
+		var runReport *report.Report
+		runReport, sim.err = sim.instance.Run(ctx)
+		sim.result = newResult(runReport)
+	}()
+
+	return nil
+}
+
+// Stop run gracefully, no new requests are sent and in-flight ones may finish within shutdown timeout
+func (sim *Simulator) Stop() {
+	sim.instance.Stop()
+}
+
+// Wait until started run finished and return its result, result is nil if run failed before sending requests
+func (sim *Simulator) Wait() (*Result, error) {
+	if !sim.started.Load() {
+		return nil, ErrNotStarted
+	}
+	<-sim.done
+
+	return sim.result, sim.err
+}
+
+// Run until every cargo unit reached warehouse, load profile ended or ctx is done and return result of the run
+func (sim *Simulator) Run(ctx context.Context) (*Result, error) {
+	if startErr := sim.Start(ctx); startErr != nil {
+		return nil, startErr
+	}
+
+	return sim.Wait()
+}
+
+// Seed pointer for Options.Seed
+func Seed(seed int64) *int64 {
+	return &seed
+}
+
+// observe event by hooks
+func (h Hooks) observe(event Event) {
+	if h.OnRequest != nil {
+		h.OnRequest(event)
+	}
+	if h.OnDelivered != nil && event.Operation == OperationUnitReachedWarehouse && event.Err == nil {
+		h.OnDelivered(event)
+	}
+}
+
+// newConfig of logistics client with defaults overridden by opts
+func newConfig(opts Options) (*config.ClientAppConfig, error) {
+	cfg := &config.ClientAppConfig{}
+	cfg.LoadDefaults()
+
+	if len(opts.Address) > 0 {
+		host, port, splitErr := net.SplitHostPort(opts.Address)
+		if splitErr != nil {
+			return nil, fmt.Errorf("invalid address %q, error: %w", opts.Address, splitErr)
+		}
+		cfg.Host, cfg.Port = host, port
+	}
+	cfg.TransportTypeProtocol = opts.Transport
+	cfg.Scheme = opts.Scheme
+	if opts.Seed != nil {
+		cfg.Seed = *opts.Seed
+	}
+	cfg.TLS = config.ClientTLSConfig{
+		Enabled:    opts.TLS.Enabled || opts.TLS.Config != nil,
+		CAFile:     opts.TLS.CAFile,
+		CertFile:   opts.TLS.CertFile,
+		KeyFile:    opts.TLS.KeyFile,
+		ServerName: opts.TLS.ServerName,
+	}
+	cfg.Auth = config.ClientAuthConfig(opts.Auth)
+
+	if opts.World.Warehouses > 0 {
+		cfg.World.MinWarehouses, cfg.World.MaxWarehouses = opts.World.Warehouses, opts.World.Warehouses
+	}
+	if opts.World.CargoUnits > 0 {
+		cfg.World.MinCargoUnits, cfg.World.MaxCargoUnits = opts.World.CargoUnits, opts.World.CargoUnits
+	}
+	if opts.World.Width > 0 {
+		cfg.World.Width = opts.World.Width
+	}
+	if opts.World.Height > 0 {
+		cfg.World.Height = opts.World.Height
+	}
+
+	cfg.Load.Rate = opts.Load.Rate
+	cfg.Load.Profile = opts.Load.Profile
+	if opts.Load.MaxInFlight < 0 {
+		return nil, fmt.Errorf("negative max in-flight requests %d", opts.Load.MaxInFlight)
+	}
+	cfg.Load.MaxInFlight = opts.Load.MaxInFlight
+	switch opts.Load.ProfileTarget {
+	case "":
+	case LoadTargetRate, LoadTargetConcurrency:
+		cfg.Load.ProfileTarget = opts.Load.ProfileTarget
+	default:
+		return nil, fmt.Errorf("unknown load profile target %q", opts.Load.ProfileTarget)
+	}
+
+	if opts.RetryMaxAttempts > 0 {
+		cfg.Retry.MaxAttempts = opts.RetryMaxAttempts
+	}
+	if opts.ShutdownTimeout > 0 {
+		cfg.ShutdownTimeout = opts.ShutdownTimeout
+	}
+
+	return cfg, nil
+}
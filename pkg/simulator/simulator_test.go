@@ -0,0 +1,186 @@
+package simulator
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	apiv1 "github.com/coopnorge/interview-backend/internal/generated/logistics/api/v1"
+	"github.com/coopnorge/interview-backend/internal/logistics/config"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/server"
+	"github.com/coopnorge/interview-backend/internal/logistics/services/store"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// startServer with reference implementation of API on random local port
+func startServer(t *testing.T) string {
+	t.Helper()
+
+	listener, listenErr := net.Listen("tcp", "127.0.0.1:0")
+	if listenErr != nil {
+		t.Fatalf("Not expected error from Listen, error: %v", listenErr)
+	}
+
+	grpcServer := grpc.NewServer()
+	apiv1.RegisterCoopLogisticsEngineAPIServer(
+		grpcServer,
+		server.NewLogisticsServer(store.NewMemoryStore(), server.NewMovementBroker(), server.NewUnitCounter(), server.NewIdempotencyCache()),
+	)
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+
+	return listener.Addr().String()
+}
+
+func TestSimulatorRun(t *testing.T) {
+	var requests atomic.Uint64
+	var deliveredLock sync.Mutex
+	delivered := make(map[int64]bool)
+
+	sim, newErr := New(Options{
+		Address: startServer(t),
+		Seed:    Seed(42),
+		World:   WorldOptions{Warehouses: 3, CargoUnits: 5, Width: 20, Height: 20},
+		Hooks: Hooks{
+			OnRequest: func(Event) { requests.Add(1) },
+			OnDelivered: func(event Event) {
+				deliveredLock.Lock()
+				defer deliveredLock.Unlock()
+				delivered[event.CargoUnitID] = true
+			},
+		},
+	})
+	if newErr != nil {
+		t.Fatalf("Not expected error from New, error: %v", newErr)
+	}
+
+	result, runErr := sim.Run(context.Background())
+	if runErr != nil {
+		t.Fatalf("Not expected error from Run, error: %v", runErr)
+	}
+
+	if result.World.Warehouses != 3 || result.World.CargoUnits != 5 {
+		t.Errorf("Expected world of 3 warehouses and 5 cargo units, but got %+v", result.World)
+	}
+	if len(delivered) != 5 {
+		t.Errorf("Expected 5 delivered cargo units, but got %d", len(delivered))
+	}
+
+	var sent uint64
+	for _, operation := range result.Operations {
+		sent += operation.Count
+		if operation.Errors != 0 {
+			t.Errorf("Expected no errors of %s, but got %d", operation.Name, operation.Errors)
+		}
+	}
+	if sent == 0 || sent != requests.Load() {
+		t.Errorf("Expected every of %d sent requests to be observed, but got %d", sent, requests.Load())
+	}
+	if failed := result.Failed(); len(failed) > 0 {
+		t.Errorf("Expected every check to pass, but got %+v", failed)
+	}
+
+	if _, err := sim.Run(context.Background()); !errors.Is(err, ErrStarted) {
+		t.Errorf("Expected ErrStarted from second Run, but got %v", err)
+	}
+}
+
+func TestSimulatorRunOverDialerWithTLSAndAuth(t *testing.T) {
+	ls := server.NewLogisticsServer(store.NewMemoryStore(), server.NewMovementBroker(), server.NewUnitCounter(), server.NewIdempotencyCache())
+	mux := server.NewGatewayMux()
+	if err := apiv1.RegisterCoopLogisticsEngineAPIHandlerServer(context.Background(), mux, ls); err != nil {
+		t.Fatalf("Not expected error from RegisterCoopLogisticsEngineAPIHandlerServer, error: %v", err)
+	}
+	authenticator := server.NewAuthenticator(&config.ServerAppConfig{AuthToken: "secret"})
+
+	listener := bufconn.Listen(1 << 20)
+	httpServer := httptest.NewUnstartedServer(authenticator.HTTPHandler(mux))
+	httpServer.Listener = listener
+	httpServer.StartTLS()
+	defer httpServer.Close()
+
+	sim, newErr := New(Options{
+		// host name of httptest certificate, resolved only by dialer
+		Address:   "example.com:443",
+		Transport: "HTTPProtobuf",
+		TLS:       TLSOptions{Config: httpServer.Client().Transport.(*http.Transport).TLSClientConfig},
+		Auth:      AuthOptions{Token: "secret"},
+		Dialer: func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		},
+		Seed:  Seed(0),
+		World: WorldOptions{Warehouses: 2, CargoUnits: 3, Width: 10, Height: 10},
+	})
+	if newErr != nil {
+		t.Fatalf("Not expected error from New, error: %v", newErr)
+	}
+
+	result, runErr := sim.Run(context.Background())
+	if runErr != nil {
+		t.Fatalf("Not expected error from Run, error: %v", runErr)
+	}
+	if result.Seed != 0 {
+		t.Errorf("Expected run with requested seed 0, but got %d", result.Seed)
+	}
+	if !result.Config.TLS {
+		t.Errorf("Expected TLS in run config, but got %+v", result.Config)
+	}
+	for _, operation := range result.Operations {
+		if operation.Count == 0 || operation.Errors != 0 {
+			t.Errorf("Expected requests of %s without errors, but got %d of %d failed", operation.Name, operation.Errors, operation.Count)
+		}
+	}
+	if failed := result.Failed(); len(failed) > 0 {
+		t.Errorf("Expected every check to pass, but got %+v", failed)
+	}
+}
+
+func TestSimulatorStop(t *testing.T) {
+	var sim *Simulator
+	var stopOnce sync.Once
+
+	sim, newErr := New(Options{
+		Address: startServer(t),
+		World:   WorldOptions{Warehouses: 1, CargoUnits: 50, Width: 1000, Height: 1000},
+		Hooks: Hooks{
+			OnRequest: func(Event) { stopOnce.Do(sim.Stop) },
+		},
+	})
+	if newErr != nil {
+		t.Fatalf("Not expected error from New, error: %v", newErr)
+	}
+
+	if _, err := sim.Wait(); !errors.Is(err, ErrNotStarted) {
+		t.Errorf("Expected ErrNotStarted from Wait before Start, but got %v", err)
+	}
+	if err := sim.Start(context.Background()); err != nil {
+		t.Fatalf("Not expected error from Start, error: %v", err)
+	}
+
+	result, runErr := sim.Wait()
+	if !errors.Is(runErr, ErrInterrupted) {
+		t.Errorf("Expected ErrInterrupted, but got %v", runErr)
+	}
+	if result == nil || !result.Interrupted {
+		t.Errorf("Expected partial result marked as interrupted, but got %+v", result)
+	}
+}
+
+func TestNewRejectsInvalidOptions(t *testing.T) {
+	for _, opts := range []Options{
+		{Address: "no port"},
+		{Transport: "carrier pigeon"},
+		{Load: LoadOptions{Profile: "ramp:0-10/1s", ProfileTarget: "everything"}},
+		{Load: LoadOptions{Profile: "unknown"}},
+	} {
+		if _, err := New(opts); err == nil {
+			t.Errorf("Expected error from New with %+v", opts)
+		}
+	}
+}